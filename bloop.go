@@ -0,0 +1,289 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	reasonLoopVarUsed  = "the loop variable is used"
+	reasonSeveralLoops = "the benchmark has several loops over b.N"
+	reasonNestedLoop   = "the loop is inside another loop"
+	reasonBNUsed       = "b.N is used outside of the loop condition"
+)
+
+// bLoop is a loop over `b.N`.
+type bLoop struct {
+	stmt ast.Stmt
+	body *ast.BlockStmt
+
+	// key is the loop variable (nil if none).
+	key *ast.Ident
+
+	// n is the `b.N` expression of the loop condition.
+	n ast.Expr
+
+	// resets are the calls to `b.ResetTimer()` before the loop.
+	resets []ast.Stmt
+
+	// nested is true when the loop is inside another loop.
+	nested bool
+}
+
+// reportBLoop reports the loops over `b.N` inside the benchmarks:
+//
+//	b.ResetTimer()
+//	for i := 0; i < b.N; i++ {}
+//
+// becomes:
+//
+//	for b.Loop() {}
+//
+// The calls to `b.ResetTimer()` before the loop are removed: the timer is reset by the first call to `b.Loop()`.
+func reportBLoop(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	// Only `*testing.B` has a `Loop` method.
+	if !hasArgName(fnInfo) || !hasMethod(fnInfo.Type, loopName) {
+		return
+	}
+
+	loops := findBLoops(pass, block, ignored, fnInfo)
+
+	for _, loop := range loops {
+		diagnostic := analysis.Diagnostic{
+			Pos: loop.stmt.Pos(),
+			End: loop.body.Lbrace,
+			Message: fmt.Sprintf("loop over %s.N could be replaced by %s.%s() in %s",
+				fnInfo.ArgName, fnInfo.ArgName, loopName, fnInfo.Name,
+			),
+		}
+
+		switch {
+		case len(loops) > 1:
+			diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonSeveralLoops)
+
+		case loop.nested:
+			diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonNestedLoop)
+
+		case isLoopVarUsed(pass, loop):
+			diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonLoopVarUsed)
+
+		case isBNUsed(pass, block, ignored, loop, fnInfo):
+			diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonBNUsed)
+
+		default:
+			edits := []analysis.TextEdit{{
+				Pos:     loop.stmt.Pos(),
+				End:     loop.body.Lbrace,
+				NewText: fmt.Appendf(nil, "for %s.%s() ", fnInfo.ArgName, loopName),
+			}}
+
+			for _, reset := range loop.resets {
+				edits = append(edits, deleteStmt(pass, reset))
+
+				diagnostic.Related = append(diagnostic.Related, analysis.RelatedInformation{
+					Pos:     reset.Pos(),
+					End:     reset.End(),
+					Message: "the timer is reset by " + fnInfo.ArgName + "." + loopName + "()",
+				})
+			}
+
+			diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{TextEdits: edits})
+		}
+
+		pass.Report(diagnostic)
+	}
+}
+
+// findBLoops finds the loops over `b.N`:
+//
+//	for i := 0; i < b.N; i++ {}
+//	for range b.N {}
+func findBLoops(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) []*bLoop {
+	var (
+		loops []*bLoop
+
+		// all the loops of the function.
+		all []ast.Stmt
+	)
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		var stmts []ast.Stmt
+
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+		case *ast.ForStmt, *ast.RangeStmt:
+			all = append(all, v.(ast.Stmt))
+			return true
+		case *ast.BlockStmt:
+			stmts = v.List
+		case *ast.CaseClause:
+			stmts = v.Body
+		case *ast.CommClause:
+			stmts = v.Body
+		default:
+			return true
+		}
+
+		var resets []ast.Stmt
+
+		for _, stmt := range stmts {
+			if isResetTimer(pass, stmt, fnInfo) {
+				resets = append(resets, stmt)
+				continue
+			}
+
+			loop, ok := matchBLoop(pass, stmt, fnInfo)
+			if !ok {
+				continue
+			}
+
+			loop.resets = resets
+			resets = nil
+
+			loops = append(loops, loop)
+		}
+
+		return true
+	})
+
+	// `b.Loop()` stops the timer at the end of the loop: it cannot be called again by an outer loop.
+	for _, loop := range loops {
+		loop.nested = slices.ContainsFunc(all, func(outer ast.Stmt) bool {
+			return outer != loop.stmt && outer.Pos() <= loop.stmt.Pos() && loop.stmt.End() <= outer.End()
+		})
+	}
+
+	return loops
+}
+
+func matchBLoop(pass *analysis.Pass, stmt ast.Stmt, fnInfo *FuncInfo) (*bLoop, bool) {
+	switch st := stmt.(type) {
+	case *ast.ForStmt:
+		init, ok := st.Init.(*ast.AssignStmt)
+		if !ok || init.Tok != token.DEFINE || len(init.Lhs) != 1 || len(init.Rhs) != 1 || !isZero(pass, init.Rhs[0]) {
+			return nil, false
+		}
+
+		key, ok := init.Lhs[0].(*ast.Ident)
+		if !ok {
+			return nil, false
+		}
+
+		obj := pass.TypesInfo.ObjectOf(key)
+
+		cond, ok := st.Cond.(*ast.BinaryExpr)
+		if !ok || cond.Op != token.LSS || !isIdentOf(pass, cond.X, obj) || !isBN(pass, cond.Y, fnInfo) {
+			return nil, false
+		}
+
+		post, ok := st.Post.(*ast.IncDecStmt)
+		if !ok || post.Tok != token.INC || !isIdentOf(pass, post.X, obj) {
+			return nil, false
+		}
+
+		return &bLoop{stmt: st, body: st.Body, key: key, n: cond.Y}, true
+
+	case *ast.RangeStmt:
+		if st.Value != nil || !isBN(pass, st.X, fnInfo) {
+			return nil, false
+		}
+
+		loop := &bLoop{stmt: st, body: st.Body, n: st.X}
+
+		if st.Key != nil {
+			key, ok := st.Key.(*ast.Ident)
+			if !ok {
+				return nil, false
+			}
+
+			loop.key = key
+		}
+
+		return loop, true
+	}
+
+	return nil, false
+}
+
+// isLoopVarUsed checks if the loop variable is used by the loop body, or outside the loop when the variable is not defined by the loop.
+func isLoopVarUsed(pass *analysis.Pass, loop *bLoop) bool {
+	if loop.key == nil || isBlank(loop.key) {
+		return false
+	}
+
+	if pass.TypesInfo.Defs[loop.key] == nil {
+		return true
+	}
+
+	return isUsedIn(pass, pass.TypesInfo.ObjectOf(loop.key), loop.body)
+}
+
+// isBNUsed checks if `b.N` is used outside of the loop condition:
+// `b.Loop()` sets `b.N` to 0 inside the loop, and `b.N` is 1 before the first call to `b.Loop()`.
+func isBNUsed(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, loop *bLoop, fnInfo *FuncInfo) bool {
+	var used bool
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+
+		case *ast.SelectorExpr:
+			if v != loop.n && isBN(pass, v, fnInfo) {
+				used = true
+			}
+		}
+
+		return !used
+	})
+
+	return used
+}
+
+// isBN checks if the expression is the `N` field of the testing handle.
+func isBN(pass *analysis.Pass, expr ast.Expr, fnInfo *FuncInfo) bool {
+	se, ok := expr.(*ast.SelectorExpr)
+	if !ok || se.Sel.Name != nName || types.ExprString(se.X) != fnInfo.ArgName {
+		return false
+	}
+
+	field, ok := pass.TypesInfo.ObjectOf(se.Sel).(*types.Var)
+
+	return ok && field.IsField() && field.Pkg() != nil && field.Pkg().Path() == testingPkgName
+}
+
+// isResetTimer checks if the statement is a call to `b.ResetTimer()` on the testing handle.
+func isResetTimer(pass *analysis.Pass, stmt ast.Stmt, fnInfo *FuncInfo) bool {
+	es, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	call, ok := isFuncCall(pass, es.X, testingPkgName, resetTimerName)
+	if !ok {
+		return false
+	}
+
+	se, ok := call.Fun.(*ast.SelectorExpr)
+
+	return ok && types.ExprString(se.X) == fnInfo.ArgName
+}
+
+func isIdentOf(pass *analysis.Pass, expr ast.Expr, obj types.Object) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && obj != nil && pass.TypesInfo.ObjectOf(ident) == obj
+}
+
+func isZero(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+
+	return ok && tv.Value != nil && constant.Sign(tv.Value) == 0
+}
@@ -0,0 +1,142 @@
+package usetesting
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// collectChdir computes the fixes of `os.Chdir` calls:
+//
+//	wd, _ := os.Getwd()
+//	os.Chdir("foo")
+//	defer os.Chdir(wd)
+//
+// becomes:
+//
+//	t.Chdir("foo")
+func (r *rewrites) collectChdir(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, fnInfo *FuncInfo) {
+	for i := range stmts {
+		if used[i] {
+			continue
+		}
+
+		cs := matchCallStmt(pass, stmts, i, osPkgName, chdirName)
+		if cs == nil || len(cs.call.Args) != 1 {
+			continue
+		}
+
+		rw := &rewrite{edits: cs.replace(fnInfo.ArgName + "." + chdirName)}
+
+		var removed []int
+
+		for j := cs.first; j <= cs.last; j++ {
+			used[j] = true
+			removed = append(removed, j)
+		}
+
+		r.add(cs.call.Fun, rw)
+
+		saveIdx, saveLast, wd := findWdSave(pass, stmts[:cs.first], used)
+		if wd == nil {
+			continue
+		}
+
+		restoreIdx := findRestore(pass, stmts, used, saveIdx+1, func(stmts []ast.Stmt) bool {
+			return isWdRestore(pass, stmts, wd)
+		})
+		if restoreIdx < 0 {
+			continue
+		}
+
+		used[restoreIdx] = true
+		removed = append(removed, restoreIdx)
+
+		restore := stmts[restoreIdx]
+
+		rw.edits = append(rw.edits, deleteStmt(pass, restore))
+		rw.related = append(rw.related, analysis.RelatedInformation{
+			Pos:     restore.Pos(),
+			End:     restore.End(),
+			Message: "the working directory is restored here",
+		})
+
+		r.skip(pass, restore, osPkgName, chdirName)
+
+		for j := saveIdx; j <= saveLast; j++ {
+			removed = append(removed, j)
+		}
+
+		if !isRemovable(pass, stmts[saveIdx], stmts, removed, cs.call.Args...) {
+			continue
+		}
+
+		for j := saveIdx; j <= saveLast; j++ {
+			used[j] = true
+
+			rw.edits = append(rw.edits, deleteStmt(pass, stmts[j]))
+		}
+	}
+}
+
+// findWdSave finds the last statement saving the working directory:
+//
+//	wd, _ := os.Getwd()
+//	wd, err := os.Getwd()
+//	if err != nil { ... }
+func findWdSave(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool) (first, last int, wd types.Object) {
+	for i := len(stmts) - 1; i >= 0; i-- {
+		if used[i] {
+			continue
+		}
+
+		assign, ok := stmts[i].(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			continue
+		}
+
+		if _, ok := isFuncCall(pass, assign.Rhs[0], osPkgName, getwdName); !ok {
+			continue
+		}
+
+		wdIdent, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok || isBlank(wdIdent) {
+			return -1, -1, nil
+		}
+
+		last := i
+
+		errIdent, ok := assign.Lhs[1].(*ast.Ident)
+		if ok && !isBlank(errIdent) && i+1 < len(stmts) && !used[i+1] && isErrCheck(pass, stmts[i+1], errIdent) {
+			last = i + 1
+		}
+
+		return i, last, pass.TypesInfo.ObjectOf(wdIdent)
+	}
+
+	return -1, -1, nil
+}
+
+// isWdRestore checks if the statements only restore the working directory.
+func isWdRestore(pass *analysis.Pass, stmts []ast.Stmt, wd types.Object) bool {
+	if len(stmts) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(stmts); i++ {
+		cs := matchCallStmt(pass, stmts, i, osPkgName, chdirName)
+		if cs == nil || len(cs.call.Args) != 1 {
+			return false
+		}
+
+		ident, ok := cs.call.Args[0].(*ast.Ident)
+		if !ok || pass.TypesInfo.Uses[ident] != wd {
+			return false
+		}
+
+		i = cs.last
+	}
+
+	return true
+}
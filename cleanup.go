@@ -0,0 +1,127 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// cleanupFunc is a function literal registered as a cleanup function.
+type cleanupFunc struct {
+	fn *ast.FuncLit
+
+	// recv is the testing handle registering the function (ex: `t` in `t.Cleanup(fn)`).
+	recv ast.Expr
+}
+
+// cleanupFuncs returns the function literals registered as cleanup functions:
+//
+//	t.Cleanup(func() { ... })
+//
+//	fn := func() { ... }
+//	t.Cleanup(fn)
+func cleanupFuncs(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool) []cleanupFunc {
+	vars := funcLitVars(pass, block, ignored)
+
+	var cleanups []cleanupFunc
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			return !ignored[fn]
+		}
+
+		call, ok := isFuncCall(pass, n, testingPkgName, cleanupName)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+
+		se, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		for _, fn := range funcLits(pass, vars, call.Args[0]) {
+			cleanups = append(cleanups, cleanupFunc{fn: fn, recv: se.X})
+		}
+
+		// The nested cleanup functions are covered by this one.
+		return false
+	})
+
+	return cleanups
+}
+
+// reportCleanupContext reports the uses of `t.Context()` inside the cleanup functions:
+// the context is canceled just before the cleanup functions run.
+// Only the context of the handle registering the cleanup function is concerned:
+// the context of a parent test is still alive when the cleanup functions of a subtest run.
+func reportCleanupContext(pass *analysis.Pass, cleanups []cleanupFunc, fnInfo *FuncInfo) {
+	for _, cleanup := range cleanups {
+		ast.Inspect(cleanup.fn.Body, func(n ast.Node) bool {
+			call, ok := isFuncCall(pass, n, testingPkgName, contextName)
+			if !ok {
+				return true
+			}
+
+			se, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || !sameExpr(se.X, cleanup.recv) {
+				return true
+			}
+
+			diagnostic := analysis.Diagnostic{
+				Pos: call.Pos(),
+				End: call.End(),
+				Message: fmt.Sprintf("%s.%s() could be replaced by %s.%s() in %s: the context is canceled before the cleanup functions run",
+					types.ExprString(se.X), contextName, contextPkgName, backgroundName, fnInfo.Name,
+				),
+			}
+
+			if name, ok := importName(pass, call, contextPkgName); ok {
+				prefix := name + "."
+				if name == "." {
+					prefix = ""
+				}
+
+				diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+					TextEdits: []analysis.TextEdit{{
+						Pos:     call.Pos(),
+						End:     call.End(),
+						NewText: []byte(prefix + backgroundName + "()"),
+					}},
+				})
+			}
+
+			pass.Report(diagnostic)
+
+			return true
+		})
+	}
+}
+
+// importName returns the name of a package imported by the file containing the node.
+func importName(pass *analysis.Pass, node ast.Node, pkgPath string) (string, bool) {
+	for _, file := range pass.Files {
+		if node.Pos() < file.FileStart || file.FileEnd <= node.Pos() {
+			continue
+		}
+
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || path != pkgPath {
+				continue
+			}
+
+			pkgName := pass.TypesInfo.PkgNameOf(spec)
+			if pkgName == nil || pkgName.Name() == "_" {
+				return "", false
+			}
+
+			return pkgName.Name(), true
+		}
+	}
+
+	return "", false
+}
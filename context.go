@@ -0,0 +1,125 @@
+package usetesting
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const reasonNotTestFunc = "the context is canceled when the function returns, and not at the end of the test"
+
+// collectWithCancel computes the fixes of the contexts only derived to be canceled at the end of the test:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+// becomes:
+//
+//	ctx := t.Context()
+//
+// The other derived contexts (`context.WithTimeout`, `signal.NotifyContext`, ...) keep their wrapper.
+// Only the test functions and the subtest functions are concerned.
+func (r *rewrites) collectWithCancel(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, fnInfo *FuncInfo, names ...string) {
+	for i, stmt := range stmts {
+		if used[i] {
+			continue
+		}
+
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			continue
+		}
+
+		call, ok := isFuncCall(pass, assign.Rhs[0], contextPkgName, withCancelName)
+		if !ok || len(call.Args) != 1 {
+			continue
+		}
+
+		parent, ok := isFuncCall(pass, call.Args[0], contextPkgName, names...)
+		if !ok {
+			continue
+		}
+
+		if !fnInfo.TestFunc {
+			// The context of a helper is canceled when the helper returns, and not at the end of the test.
+			r.add(parent.Fun, &rewrite{reason: reasonNotTestFunc})
+			continue
+		}
+
+		ctxIdent, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok || isBlank(ctxIdent) {
+			continue
+		}
+
+		cancelIdent, ok := assign.Lhs[1].(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		rw := &rewrite{}
+
+		if !isBlank(cancelIdent) {
+			cancel := pass.TypesInfo.ObjectOf(cancelIdent)
+
+			restoreIdx := findRestore(pass, stmts, used, i+1, func(stmts []ast.Stmt) bool {
+				return isCancel(pass, stmts, cancel)
+			})
+			if restoreIdx < 0 {
+				continue
+			}
+
+			if isUsedIn(pass, cancel, stmts[i+1:restoreIdx]...) || isUsedIn(pass, cancel, stmts[restoreIdx+1:]...) {
+				continue
+			}
+
+			restore := stmts[restoreIdx]
+
+			used[restoreIdx] = true
+
+			rw.edits = append(rw.edits, deleteStmt(pass, restore))
+			rw.related = append(rw.related, analysis.RelatedInformation{
+				Pos:     restore.Pos(),
+				End:     restore.End(),
+				Message: "the context is canceled here",
+			})
+		}
+
+		used[i] = true
+
+		tok := token.DEFINE
+		if assign.Tok != token.DEFINE || pass.TypesInfo.Defs[ctxIdent] == nil {
+			tok = token.ASSIGN
+		}
+
+		rw.edits = append(rw.edits, analysis.TextEdit{
+			Pos:     assign.Pos(),
+			End:     assign.End(),
+			NewText: []byte(ctxIdent.Name + " " + tok.String() + " " + fnInfo.ArgName + "." + contextName + "()"),
+		})
+
+		r.add(parent.Fun, rw)
+	}
+}
+
+// isCancel checks if the statements only call the cancel function.
+func isCancel(pass *analysis.Pass, stmts []ast.Stmt, cancel types.Object) bool {
+	if len(stmts) != 1 {
+		return false
+	}
+
+	expr, ok := stmts[0].(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+
+	call, ok := expr.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+
+	ident, ok := call.Fun.(*ast.Ident)
+
+	return ok && pass.TypesInfo.Uses[ident] == cancel
+}
@@ -0,0 +1,208 @@
+package usetesting
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// escapingFuncs returns the function literals that can run after the end of the test:
+// returned, stored in a package variable, sent on a channel, or started by a `go` statement without a join.
+//
+// The testing handle is no longer usable inside them:
+// `t.Context()` is canceled, the `t.TempDir()` directory is removed, `t.Setenv` and `t.Chdir` are reverted.
+func escapingFuncs(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool) map[*ast.FuncLit]bool {
+	escaping := make(map[*ast.FuncLit]bool)
+
+	vars := funcLitVars(pass, block, ignored)
+
+	escape := func(expr ast.Expr) {
+		for _, fn := range funcLits(pass, vars, expr) {
+			escaping[fn] = true
+		}
+	}
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+
+		case *ast.ReturnStmt:
+			for _, result := range v.Results {
+				escape(result)
+			}
+
+		case *ast.AssignStmt:
+			if len(v.Lhs) != len(v.Rhs) {
+				return true
+			}
+
+			for i, lhs := range v.Lhs {
+				if isPackageVar(pass, lhs) {
+					escape(v.Rhs[i])
+				}
+			}
+
+		case *ast.SendStmt:
+			escape(v.Value)
+
+		case *ast.GoStmt:
+			if !isJoined(pass, block, v) {
+				escape(v.Call.Fun)
+			}
+		}
+
+		return true
+	})
+
+	return escaping
+}
+
+// funcLitVars returns the function literals held by the local variables.
+func funcLitVars(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool) map[types.Object][]*ast.FuncLit {
+	vars := make(map[types.Object][]*ast.FuncLit)
+
+	holds := func(lhs *ast.Ident, rhs ast.Expr) {
+		if fn, ok := ast.Unparen(rhs).(*ast.FuncLit); ok {
+			if obj := pass.TypesInfo.ObjectOf(lhs); obj != nil {
+				vars[obj] = append(vars[obj], fn)
+			}
+		}
+	}
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+
+		case *ast.AssignStmt:
+			if len(v.Lhs) != len(v.Rhs) {
+				return true
+			}
+
+			for i, lhs := range v.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					holds(ident, v.Rhs[i])
+				}
+			}
+
+		case *ast.ValueSpec:
+			if len(v.Names) != len(v.Values) {
+				return true
+			}
+
+			for i, name := range v.Names {
+				holds(name, v.Values[i])
+			}
+		}
+
+		return true
+	})
+
+	return vars
+}
+
+// funcLits returns the function literals designated by an expression: a function literal or a local variable.
+func funcLits(pass *analysis.Pass, vars map[types.Object][]*ast.FuncLit, expr ast.Expr) []*ast.FuncLit {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.FuncLit:
+		return []*ast.FuncLit{e}
+
+	case *ast.Ident:
+		return vars[pass.TypesInfo.Uses[e]]
+
+	default:
+		return nil
+	}
+}
+
+// isPackageVar checks if the expression is a package variable (ex: `foo`, `pkg.Foo`).
+func isPackageVar(pass *analysis.Pass, expr ast.Expr) bool {
+	var ident *ast.Ident
+
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return false
+	}
+
+	v, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok || v.IsField() || v.Pkg() == nil {
+		return false
+	}
+
+	return v.Parent() == v.Pkg().Scope()
+}
+
+// isJoined checks if the function waits for the goroutine started by the `go` statement:
+// a `Wait` method call (ex: `sync.WaitGroup`) or a channel receive, after the `go` statement or deferred.
+func isJoined(pass *analysis.Pass, block *ast.BlockStmt, goStmt *ast.GoStmt) bool {
+	var joined bool
+
+	var deferred []*ast.DeferStmt
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if joined || n == goStmt {
+			return false
+		}
+
+		if d, ok := n.(*ast.DeferStmt); ok {
+			deferred = append(deferred, d)
+		}
+
+		if n == nil || (n.Pos() < goStmt.End() && !isInside(n, deferred)) {
+			return true
+		}
+
+		joined = isJoin(pass, n)
+
+		return !joined
+	})
+
+	return joined
+}
+
+func isInside(n ast.Node, stmts []*ast.DeferStmt) bool {
+	for _, stmt := range stmts {
+		if stmt.Pos() <= n.Pos() && n.End() <= stmt.End() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isJoin(pass *analysis.Pass, n ast.Node) bool {
+	switch v := n.(type) {
+	case *ast.CallExpr:
+		fn, ok := typeutil.Callee(pass.TypesInfo, v).(*types.Func)
+		if !ok || fn.Name() != waitName {
+			return false
+		}
+
+		sig, ok := fn.Type().(*types.Signature)
+
+		return ok && sig.Recv() != nil
+
+	case *ast.UnaryExpr:
+		return v.Op == token.ARROW
+
+	case *ast.RangeStmt:
+		typ := pass.TypesInfo.TypeOf(v.X)
+		if typ == nil {
+			return false
+		}
+
+		_, ok := typ.Underlying().(*types.Chan)
+
+		return ok
+	}
+
+	return false
+}
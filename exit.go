@@ -0,0 +1,245 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	reasonGoroutine = "the call is inside a goroutine"
+	reasonEscaping  = "the function can run after the end of the test"
+
+	reasonTerminating = "the call is the terminating statement of the function"
+)
+
+// exitReplacement describes the testing method replacing a process-terminating call.
+type exitReplacement struct {
+	name    string
+	fixable bool
+}
+
+// logExitReplacements are the testing methods replacing the process-terminating functions of log.
+var logExitReplacements = map[string]exitReplacement{
+	fatalName:   {name: fatalName, fixable: true},
+	fatalfName:  {name: fatalfName, fixable: true},
+	fatallnName: {name: fatalName},
+	panicName:   {name: fatalName},
+	panicfName:  {name: fatalfName},
+	paniclnName: {name: fatalName},
+}
+
+// reportExit reports the calls terminating the process:
+// they abort the whole test binary, and skip the cleanup of the other tests.
+//
+//	os.Exit(1)
+//	log.Fatalf("foo: %v", err)
+//	panic(err)
+//
+// becomes:
+//
+//	t.Fatalf("foo: %v", err)
+//	t.Fatal(err)
+//
+// `TestMain` is not concerned: `*testing.M` is not a testing handle.
+//
+// The functions running after the end of the test are also reported, without suggested fix:
+// the process is terminated whenever they run.
+func reportExit(pass *analysis.Pass, ft *ast.FuncType, block *ast.BlockStmt, ignored, escaping map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	terminating := terminatingCalls(ft, block)
+
+	var inspect func(node ast.Node, reason string)
+
+	inspect = func(node ast.Node, reason string) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.FuncLit:
+				if !escaping[v] {
+					return !ignored[v]
+				}
+
+				if reason != "" {
+					return true
+				}
+
+				inspect(v.Body, reasonEscaping)
+
+				return false
+
+			case *ast.GoStmt:
+				if reason != "" {
+					return true
+				}
+
+				// `t.Fatal()` must be called from the goroutine running the test.
+				inspect(v.Call, reasonGoroutine)
+
+				return false
+
+			case *ast.CallExpr:
+				reportExitCall(pass, v, fnInfo, reason, terminating[v])
+			}
+
+			return true
+		})
+	}
+
+	inspect(block, "")
+}
+
+func reportExitCall(pass *analysis.Pass, call *ast.CallExpr, fnInfo *FuncInfo, reason string, terminating bool) {
+	origName, expect, ok := exitCall(pass, call)
+	if !ok {
+		return
+	}
+
+	diagnostic := analysis.Diagnostic{
+		Pos: call.Pos(),
+		End: call.End(),
+		Message: fmt.Sprintf("%s() could be replaced by %s.%s() in %s",
+			origName, fnInfo.ArgName, expect.name, fnInfo.Name,
+		),
+	}
+
+	switch {
+	case reason != "":
+		diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reason)
+
+	case expect.fixable && terminating:
+		// `panic()` is a terminating statement, `t.Fatal()` is not: the function would miss a return.
+		diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonTerminating)
+
+	case expect.fixable && hasArgName(fnInfo):
+		diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+			TextEdits: []analysis.TextEdit{{
+				Pos:     call.Fun.Pos(),
+				End:     call.Fun.End(),
+				NewText: []byte(fnInfo.ArgName + "." + expect.name),
+			}},
+		})
+	}
+
+	pass.Report(diagnostic)
+}
+
+// exitCall checks if the call terminates the process:
+// `os.Exit`, the `Fatal*` and `Panic*` functions of log, or `panic` with an error.
+// It returns the name of the call (ex: `log.Fatalf`) and its replacement.
+func exitCall(pass *analysis.Pass, call *ast.CallExpr) (string, exitReplacement, bool) {
+	switch fn := typeutil.Callee(pass.TypesInfo, call).(type) {
+	case *types.Builtin:
+		if fn.Name() != panicBuiltinName || len(call.Args) != 1 || !isError(pass.TypesInfo.TypeOf(call.Args[0])) {
+			return "", exitReplacement{}, false
+		}
+
+		return panicBuiltinName, exitReplacement{name: fatalName, fixable: true}, true
+
+	case *types.Func:
+		sig, ok := fn.Type().(*types.Signature)
+		if !ok || sig.Recv() != nil || fn.Pkg() == nil {
+			return "", exitReplacement{}, false
+		}
+
+		switch {
+		case fn.Pkg().Path() == osPkgName && fn.Name() == exitName:
+			return osPkgName + "." + exitName, exitReplacement{name: fatalName}, true
+
+		case fn.Pkg().Path() == logPkgPath:
+			expect, ok := logExitReplacements[fn.Name()]
+
+			return logPkgPath + "." + fn.Name(), expect, ok
+		}
+	}
+
+	return "", exitReplacement{}, false
+}
+
+// terminatingCalls returns the calls ending the functions with results (ex: the last `panic(err)`).
+func terminatingCalls(ft *ast.FuncType, block *ast.BlockStmt) map[*ast.CallExpr]bool {
+	calls := make(map[*ast.CallExpr]bool)
+
+	collect := func(ft *ast.FuncType, body *ast.BlockStmt) {
+		if ft.Results == nil || len(ft.Results.List) == 0 {
+			return
+		}
+
+		collectTerminatingCalls(body, calls)
+	}
+
+	collect(ft, block)
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			collect(fn.Type, fn.Body)
+		}
+
+		return true
+	})
+
+	return calls
+}
+
+// collectTerminatingCalls collects the calls being the last statement of the statement,
+// following the definition of the terminating statements.
+func collectTerminatingCalls(stmt ast.Stmt, calls map[*ast.CallExpr]bool) {
+	switch v := stmt.(type) {
+	case *ast.ExprStmt:
+		if call, ok := v.X.(*ast.CallExpr); ok {
+			calls[call] = true
+		}
+
+	case *ast.BlockStmt:
+		if len(v.List) > 0 {
+			collectTerminatingCalls(v.List[len(v.List)-1], calls)
+		}
+
+	case *ast.IfStmt:
+		collectTerminatingCalls(v.Body, calls)
+
+		if v.Else != nil {
+			collectTerminatingCalls(v.Else, calls)
+		}
+
+	case *ast.LabeledStmt:
+		collectTerminatingCalls(v.Stmt, calls)
+
+	case *ast.SwitchStmt:
+		collectClausesTerminatingCalls(v.Body, calls)
+
+	case *ast.TypeSwitchStmt:
+		collectClausesTerminatingCalls(v.Body, calls)
+
+	case *ast.SelectStmt:
+		collectClausesTerminatingCalls(v.Body, calls)
+	}
+}
+
+func collectClausesTerminatingCalls(body *ast.BlockStmt, calls map[*ast.CallExpr]bool) {
+	for _, clause := range body.List {
+		var stmts []ast.Stmt
+
+		switch c := clause.(type) {
+		case *ast.CaseClause:
+			stmts = c.Body
+		case *ast.CommClause:
+			stmts = c.Body
+		}
+
+		if len(stmts) > 0 {
+			collectTerminatingCalls(stmts[len(stmts)-1], calls)
+		}
+	}
+}
+
+func isError(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	errorType, ok := types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+	return ok && types.Implements(typ, errorType)
+}
@@ -0,0 +1,91 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const reasonResultUsed = "the result is used"
+
+// fmtPrintReplacements are the testing methods replacing the print functions of fmt.
+var fmtPrintReplacements = map[string]string{
+	printName:   logName,
+	printfName:  logfName,
+	printlnName: logName,
+}
+
+// reportFmtPrint reports the print functions of fmt:
+// their output bypasses the output of the test.
+//
+//	fmt.Println("foo")
+//	fmt.Printf("foo %s", bar)
+//
+// becomes:
+//
+//	t.Log("foo")
+//	t.Logf("foo %s", bar)
+func reportFmtPrint(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	// The results of the calls used as statements, deferred, or started as goroutines are discarded.
+	discarded := make(map[*ast.CallExpr]bool)
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+
+		case *ast.ExprStmt:
+			if call, ok := v.X.(*ast.CallExpr); ok {
+				discarded[call] = true
+			}
+
+			return true
+
+		case *ast.DeferStmt:
+			discarded[v.Call] = true
+
+			return true
+
+		case *ast.GoStmt:
+			discarded[v.Call] = true
+
+			return true
+		}
+
+		call, ok := isFuncCall(pass, n, fmtPkgName, printName, printfName, printlnName)
+		if !ok {
+			return true
+		}
+
+		name := typeutil.Callee(pass.TypesInfo, call).Name()
+		expectName := fmtPrintReplacements[name]
+
+		diagnostic := analysis.Diagnostic{
+			Pos: call.Pos(),
+			End: call.End(),
+			Message: fmt.Sprintf("%s.%s() could be replaced by %s.%s() in %s",
+				fmtPkgName, name, fnInfo.ArgName, expectName, fnInfo.Name,
+			),
+		}
+
+		switch {
+		case !discarded[call]:
+			diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonResultUsed)
+
+		case hasArgName(fnInfo):
+			diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+				TextEdits: []analysis.TextEdit{{
+					Pos:     call.Fun.Pos(),
+					End:     call.Fun.End(),
+					NewText: []byte(fnInfo.ArgName + "." + expectName),
+				}},
+			})
+		}
+
+		pass.Report(diagnostic)
+
+		return true
+	})
+}
@@ -0,0 +1,208 @@
+package usetesting
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	reasonNotShortVarDecl   = "the results are not assigned by a short variable declaration"
+	reasonExistingVariables = "the results are assigned to existing variables"
+	reasonErrorUsed         = "the error is used outside of the error check"
+	reasonIfScoped          = "the directory is only available inside the if statement"
+	reasonParentDir         = "the directory is not created inside the system temporary directory"
+)
+
+// collectMkdirTemp computes the fixes of `os.MkdirTemp` calls:
+//
+//	dir, err := os.MkdirTemp("", "foo")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer os.RemoveAll(dir)
+//
+// becomes:
+//
+//	dir := t.TempDir()
+func (r *rewrites) collectMkdirTemp(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, fnInfo *FuncInfo) {
+	tempDir := fnInfo.ArgName + "." + tempDirName + "()"
+
+	for i, stmt := range stmts {
+		if used[i] {
+			continue
+		}
+
+		switch st := stmt.(type) {
+		case *ast.ExprStmt:
+			call, ok := isMkdirTempCall(pass, st.X)
+			if !ok {
+				continue
+			}
+
+			used[i] = true
+
+			r.add(call.Fun, &rewrite{edits: []analysis.TextEdit{{Pos: call.Pos(), End: call.End(), NewText: []byte(tempDir)}}})
+
+		case *ast.AssignStmt:
+			r.collectMkdirTempAssign(pass, stmts, i, used, tempDir)
+
+		case *ast.IfStmt:
+			init, ok := st.Init.(*ast.AssignStmt)
+			if !ok || len(init.Lhs) != 2 || len(init.Rhs) != 1 {
+				continue
+			}
+
+			call, ok := isMkdirTempCall(pass, init.Rhs[0])
+			if !ok {
+				continue
+			}
+
+			if !isBlank(init.Lhs[0]) {
+				r.add(call.Fun, &rewrite{reason: reasonIfScoped})
+				continue
+			}
+
+			errIdent, ok := init.Lhs[1].(*ast.Ident)
+			if !ok || init.Tok != token.DEFINE || st.Else != nil || !isErrCond(pass, st.Cond, errIdent) {
+				continue
+			}
+
+			used[i] = true
+
+			r.add(call.Fun, &rewrite{edits: []analysis.TextEdit{{Pos: st.Pos(), End: st.End(), NewText: []byte(tempDir)}}})
+		}
+	}
+}
+
+func (r *rewrites) collectMkdirTempAssign(pass *analysis.Pass, stmts []ast.Stmt, i int, used map[int]bool, tempDir string) {
+	assign, ok := stmts[i].(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return
+	}
+
+	call, ok := isMkdirTempCall(pass, assign.Rhs[0])
+	if !ok {
+		return
+	}
+
+	dirExpr, errExpr := assign.Lhs[0], assign.Lhs[1]
+
+	if assign.Tok != token.DEFINE && (!isBlank(dirExpr) || !isBlank(errExpr)) {
+		r.add(call.Fun, &rewrite{reason: reasonExistingVariables})
+		return
+	}
+
+	last, end := i, assign.End()
+
+	if errIdent, ok := errExpr.(*ast.Ident); ok && !isBlank(errIdent) {
+		if i+1 < len(stmts) && isErrCheck(pass, stmts[i+1], errIdent) {
+			last, end = i+1, stmts[i+1].End()
+		}
+
+		if isUsedIn(pass, pass.TypesInfo.ObjectOf(errIdent), stmts[last+1:]...) {
+			r.add(call.Fun, &rewrite{reason: reasonErrorUsed})
+			return
+		}
+	}
+
+	for j := i; j <= last; j++ {
+		used[j] = true
+	}
+
+	rw := &rewrite{}
+	r.add(call.Fun, rw)
+
+	dirIdent, ok := dirExpr.(*ast.Ident)
+	if !ok || isBlank(dirIdent) {
+		rw.edits = append(rw.edits, analysis.TextEdit{Pos: assign.Pos(), End: end, NewText: []byte(tempDir)})
+		return
+	}
+
+	tok := token.DEFINE
+	if pass.TypesInfo.Defs[dirIdent] == nil {
+		// The variable is redeclared.
+		tok = token.ASSIGN
+	}
+
+	rw.edits = append(rw.edits, analysis.TextEdit{
+		Pos:     assign.Pos(),
+		End:     end,
+		NewText: []byte(dirIdent.Name + " " + tok.String() + " " + tempDir),
+	})
+
+	removeDirCleanup(pass, stmts[last+1:], last+1, used, dirIdent, rw)
+}
+
+// explainParentDir prevents the fixes of the calls creating the directory outside the system temporary directory:
+//
+//	os.MkdirTemp(parent, "foo")
+func (r *rewrites) explainParentDir(pass *analysis.Pass, node ast.Node) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := isMkdirTempCall(pass, n)
+		if ok && len(call.Args) > 0 && !isSystemTempDir(pass, call.Args[0]) {
+			r.add(call.Fun, &rewrite{reason: reasonParentDir})
+		}
+
+		return true
+	})
+}
+
+// isMkdirTempCall checks if the expression is a call to `os.MkdirTemp` or to its deprecated equivalent `ioutil.TempDir`.
+func isMkdirTempCall(pass *analysis.Pass, node ast.Node) (*ast.CallExpr, bool) {
+	if call, ok := isFuncCall(pass, node, osPkgName, mkdirTempName); ok {
+		return call, true
+	}
+
+	return isFuncCall(pass, node, ioutilPkgPath, tempDirName)
+}
+
+// removeDirCleanup removes the statement removing the directory through a deferred call or a cleanup function:
+//
+//	defer os.RemoveAll(dir)
+//	t.Cleanup(func() { os.RemoveAll(dir) })
+func removeDirCleanup(pass *analysis.Pass, stmts []ast.Stmt, offset int, used map[int]bool, dirIdent *ast.Ident, rw *rewrite) {
+	dir := pass.TypesInfo.ObjectOf(dirIdent)
+
+	for j, stmt := range stmts {
+		if used[offset+j] || !isDirRemoval(pass, deferredFuncs(pass, stmt), dir) {
+			continue
+		}
+
+		used[offset+j] = true
+
+		rw.edits = append(rw.edits, deleteStmt(pass, stmt))
+		rw.related = append(rw.related, analysis.RelatedInformation{
+			Pos:     stmt.Pos(),
+			End:     stmt.End(),
+			Message: "the directory is removed here",
+		})
+
+		return
+	}
+}
+
+// isDirRemoval checks if the statements only remove the directory.
+func isDirRemoval(pass *analysis.Pass, stmts []ast.Stmt, dir types.Object) bool {
+	if len(stmts) == 0 || dir == nil {
+		return false
+	}
+
+	for i := 0; i < len(stmts); i++ {
+		cs := matchCallStmt(pass, stmts, i, osPkgName, removeAllName, removeName)
+		if cs == nil || len(cs.call.Args) != 1 {
+			return false
+		}
+
+		ident, ok := cs.call.Args[0].(*ast.Ident)
+		if !ok || pass.TypesInfo.Uses[ident] != dir {
+			return false
+		}
+
+		i = cs.last
+	}
+
+	return true
+}
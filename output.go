@@ -0,0 +1,124 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const reasonGlobalLogger = "the standard logger outlives the test"
+
+// reportOutput reports the loggers writing to `os.Stdout` or `os.Stderr`:
+// their output is interleaved with the output of the tests (ex: `go test -json`).
+//
+//	log.New(os.Stderr, "", 0)
+//	slog.NewTextHandler(os.Stdout, nil)
+//	log.SetOutput(os.Stdout)
+//
+// becomes:
+//
+//	log.New(t.Output(), "", 0)
+//	slog.NewTextHandler(t.Output(), nil)
+func reportOutput(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	if !hasMethod(fnInfo.Type, outputName) {
+		return
+	}
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			return !ignored[fn]
+		}
+
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 || stdStreamName(pass, call.Args[0]) == "" {
+			return true
+		}
+
+		fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		if !ok || !isLoggerFunc(fn) {
+			return true
+		}
+
+		writer := call.Args[0]
+
+		diagnostic := analysis.Diagnostic{
+			Pos: writer.Pos(),
+			End: writer.End(),
+			Message: fmt.Sprintf("%s.%s could be replaced by %s.%s() in %s",
+				osPkgName, stdStreamName(pass, writer), fnInfo.ArgName, outputName, fnInfo.Name,
+			),
+		}
+
+		switch {
+		case isGlobalSetOutput(fn):
+			diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", reasonGlobalLogger)
+
+		case hasArgName(fnInfo):
+			diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+				TextEdits: []analysis.TextEdit{{
+					Pos:     writer.Pos(),
+					End:     writer.End(),
+					NewText: fmt.Appendf(nil, "%s.%s()", fnInfo.ArgName, outputName),
+				}},
+			})
+		}
+
+		pass.Report(diagnostic)
+
+		return true
+	})
+}
+
+// isLoggerFunc checks if the function creates a logger or sets the writer of a logger:
+// the writer is the first argument.
+func isLoggerFunc(fn *types.Func) bool {
+	if fn.Pkg() == nil {
+		return false
+	}
+
+	switch fn.Pkg().Path() {
+	case logPkgPath:
+		return fn.Name() == newName || fn.Name() == setOutputName
+
+	case slogPkgPath:
+		return fn.Name() == newTextHandlerName || fn.Name() == newJSONHandlerName
+
+	default:
+		return false
+	}
+}
+
+// isGlobalSetOutput checks if the function is `log.SetOutput` (and not the method of `*log.Logger`).
+func isGlobalSetOutput(fn *types.Func) bool {
+	sig, ok := fn.Type().(*types.Signature)
+
+	return ok && sig.Recv() == nil && fn.Name() == setOutputName
+}
+
+// stdStreamName returns the name of the standard stream (`Stdout` or `Stderr`), or an empty string if the expression is not `os.Stdout` or `os.Stderr`.
+func stdStreamName(pass *analysis.Pass, expr ast.Expr) string {
+	var ident *ast.Ident
+
+	switch v := expr.(type) {
+	case *ast.Ident:
+		ident = v
+	case *ast.SelectorExpr:
+		ident = v.Sel
+	default:
+		return ""
+	}
+
+	obj, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok || obj.Pkg() == nil || obj.Pkg().Path() != osPkgName {
+		return ""
+	}
+
+	if obj.Name() != stdoutName && obj.Name() != stderrName {
+		return ""
+	}
+
+	return obj.Name()
+}
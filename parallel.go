@@ -0,0 +1,92 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	reasonParallelSetenv = "t.Setenv() panics in parallel tests"
+	reasonParallelChdir  = "t.Chdir() panics in parallel tests"
+)
+
+// isParallel checks if the function, or one of the enclosing test functions (ex: the parent of a subtest), calls `t.Parallel()`.
+// The stack contains the enclosing nodes of the function, and the function itself.
+func isParallel(pass *analysis.Pass, stack []ast.Node) bool {
+	for _, node := range stack {
+		var (
+			recv *ast.FieldList
+			ft   *ast.FuncType
+			body *ast.BlockStmt
+		)
+
+		switch fn := node.(type) {
+		case *ast.FuncDecl:
+			recv, ft, body = fn.Recv, fn.Type, fn.Body
+		case *ast.FuncLit:
+			ft, body = fn.Type, fn.Body
+		default:
+			continue
+		}
+
+		if body != nil && findTestHandle(pass, recv, ft, "") != nil && callsParallel(pass, body) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// callsParallel checks if the function calls `t.Parallel()`, the nested test functions excluded.
+func callsParallel(pass *analysis.Pass, block *ast.BlockStmt) bool {
+	var parallel bool
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if parallel {
+			return false
+		}
+
+		if fn, ok := n.(*ast.FuncLit); ok {
+			return findTestHandle(pass, nil, fn.Type, "") == nil
+		}
+
+		_, parallel = isFuncCall(pass, n, testingPkgName, parallelName)
+
+		return !parallel
+	})
+
+	return parallel
+}
+
+// reportParallel reports the calls to `t.Setenv()` and `t.Chdir()` inside parallel tests:
+// they panic when the test or one of its parents is parallel.
+func reportParallel(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	ast.Inspect(block, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			return !ignored[fn]
+		}
+
+		call, ok := isFuncCall(pass, n, testingPkgName, setenvName, chdirName)
+		if !ok {
+			return true
+		}
+
+		se, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos: call.Pos(),
+			End: call.End(),
+			Message: fmt.Sprintf("%s() cannot be used in %s: the test or one of its parents is parallel",
+				types.ExprString(se), fnInfo.Name,
+			),
+		})
+
+		return true
+	})
+}
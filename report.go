@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/printer"
 	"go/token"
+	"go/types"
 	"slices"
 	"strings"
 
@@ -24,9 +26,11 @@ func (a *analyzer) reportCallExpr(pass *analysis.Pass, ce *ast.CallExpr, fnInfo
 		return false
 	}
 
+	var pkgName, name string
+
 	switch fun := ce.Fun.(type) {
 	case *ast.SelectorExpr:
-		if fun.Sel == nil || fun.Sel.Name != createTempName {
+		if fun.Sel == nil {
 			return false
 		}
 
@@ -35,42 +39,62 @@ func (a *analyzer) reportCallExpr(pass *analysis.Pass, ce *ast.CallExpr, fnInfo
 			return false
 		}
 
-		if expr.Name == osPkgName && isFirstArgEmptyString(ce) {
-			pass.Report(diagnosticOSCreateTemp(ce, fnInfo))
-
-			return true
-		}
+		pkgName, name = expr.Name, fun.Sel.Name
 
 	case *ast.Ident:
-		if fun.Name != createTempName {
-			return false
-		}
+		pkgName, name = getPkgNameFromType(pass, fun), fun.Name
 
-		pkgName := getPkgNameFromType(pass, fun)
+	default:
+		return false
+	}
 
-		if pkgName == osPkgName && isFirstArgEmptyString(ce) {
-			pass.Report(diagnosticOSCreateTemp(ce, fnInfo))
+	if !isSystemTempDir(pass, ce.Args[0]) {
+		return false
+	}
 
-			return true
-		}
+	switch {
+	case pkgName == osPkgName && name == createTempName:
+		pass.Report(diagnosticOSCreateTemp(ce, pkgName, name, ce.Fun, fnInfo))
+
+	case pkgName == ioutilPkgName && name == tempFileName:
+		pass.Report(diagnosticOSCreateTemp(ce, pkgName, name, osFuncExpr(pass, ce, createTempName), fnInfo))
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// osFuncExpr returns the expression of a function of the os package, based on the imports of the file.
+// It returns nil when the os package is not imported.
+func osFuncExpr(pass *analysis.Pass, node ast.Node, name string) ast.Expr {
+	pkgName, ok := importName(pass, node, osPkgName)
+	if !ok {
+		return nil
+	}
+
+	if pkgName == "." {
+		return &ast.Ident{Name: name}
 	}
 
-	return false
+	return &ast.SelectorExpr{X: &ast.Ident{Name: pkgName}, Sel: &ast.Ident{Name: name}}
 }
 
-func diagnosticOSCreateTemp(ce *ast.CallExpr, fnInfo *FuncInfo) analysis.Diagnostic {
+// diagnosticOSCreateTemp creates the diagnostic of a call replaced by a call to fun (`os.CreateTemp`) inside the temporary directory.
+// The diagnostic has no suggested fix when fun is nil.
+func diagnosticOSCreateTemp(ce *ast.CallExpr, origPkgName, origName string, fun ast.Expr, fnInfo *FuncInfo) analysis.Diagnostic {
 	diagnostic := analysis.Diagnostic{
 		Pos: ce.Pos(),
 		Message: fmt.Sprintf(
-			`%s.%s("", ...) could be replaced by %[1]s.%[2]s(%s.%s(), ...) in %s`,
-			osPkgName, createTempName, fnInfo.ArgName, tempDirName, fnInfo.Name,
+			`%s.%s(%s, ...) could be replaced by %s.%s(%s.%s(), ...) in %s`,
+			origPkgName, origName, types.ExprString(ce.Args[0]), osPkgName, createTempName, fnInfo.ArgName, tempDirName, fnInfo.Name,
 		),
 	}
 
-	// Skip `<t/b>` arg names.
-	if !strings.Contains(fnInfo.ArgName, "<") {
+	if hasArgName(fnInfo) && fun != nil {
 		g := &ast.CallExpr{
-			Fun: ce.Fun,
+			Fun: fun,
 			Args: []ast.Expr{
 				&ast.CallExpr{
 					Fun: &ast.SelectorExpr{
@@ -102,7 +126,7 @@ func diagnosticOSCreateTemp(ce *ast.CallExpr, fnInfo *FuncInfo) analysis.Diagnos
 	return diagnostic
 }
 
-func (a *analyzer) reportSelector(pass *analysis.Pass, se *ast.SelectorExpr, fnInfo *FuncInfo, geGo124 bool) bool {
+func (a *analyzer) reportSelector(pass *analysis.Pass, se *ast.SelectorExpr, fnInfo *FuncInfo, rws *rewrites, geGo124 bool) bool {
 	if se.Sel == nil || !se.Sel.IsExported() {
 		return false
 	}
@@ -112,10 +136,10 @@ func (a *analyzer) reportSelector(pass *analysis.Pass, se *ast.SelectorExpr, fnI
 		return false
 	}
 
-	return a.report(pass, se, ident.Name, se.Sel.Name, fnInfo, geGo124)
+	return a.report(pass, se, ident.Name, se.Sel.Name, fnInfo, rws, geGo124)
 }
 
-func (a *analyzer) reportIdent(pass *analysis.Pass, ident *ast.Ident, fnInfo *FuncInfo, geGo124 bool) bool {
+func (a *analyzer) reportIdent(pass *analysis.Pass, ident *ast.Ident, fnInfo *FuncInfo, rws *rewrites, geGo124 bool) bool {
 	if !ident.IsExported() {
 		return false
 	}
@@ -126,29 +150,37 @@ func (a *analyzer) reportIdent(pass *analysis.Pass, ident *ast.Ident, fnInfo *Fu
 
 	pkgName := getPkgNameFromType(pass, ident)
 
-	return a.report(pass, ident, pkgName, ident.Name, fnInfo, geGo124)
+	return a.report(pass, ident, pkgName, ident.Name, fnInfo, rws, geGo124)
 }
 
 //nolint:gocyclo // The complexity is expected by the number of cases to check.
-func (a *analyzer) report(pass *analysis.Pass, rg analysis.Range, origPkgName, origName string, fnInfo *FuncInfo, geGo124 bool) bool {
+func (a *analyzer) report(pass *analysis.Pass, rg analysis.Range, origPkgName, origName string, fnInfo *FuncInfo, rws *rewrites, geGo124 bool) bool {
+	if rws.skipped[rg] {
+		// Already handled by the fix of another call.
+		return true
+	}
+
 	switch {
-	case a.osMkdirTemp && origPkgName == osPkgName && origName == mkdirTempName:
-		report(pass, rg, origPkgName, origName, tempDirName, fnInfo)
+	case a.osMkdirTemp && ((origPkgName == osPkgName && origName == mkdirTempName) || (origPkgName == ioutilPkgName && origName == tempDirName)):
+		report(pass, rg, origPkgName, origName, tempDirName, fnInfo, rws.fixes[rg])
 
 	case a.osTempDir && origPkgName == osPkgName && origName == tempDirName:
-		report(pass, rg, origPkgName, origName, tempDirName, fnInfo)
+		report(pass, rg, origPkgName, origName, tempDirName, fnInfo, rws.fixes[rg])
 
 	case a.osSetenv && origPkgName == osPkgName && origName == setenvName:
-		report(pass, rg, origPkgName, origName, setenvName, fnInfo)
+		report(pass, rg, origPkgName, origName, setenvName, fnInfo, rws.fixes[rg])
+
+	case a.osUnsetenv && origPkgName == osPkgName && (origName == unsetenvName || origName == clearenvName):
+		report(pass, rg, origPkgName, origName, setenvName, fnInfo, rws.fixes[rg])
 
 	case geGo124 && a.osChdir && origPkgName == osPkgName && origName == chdirName:
-		report(pass, rg, origPkgName, origName, chdirName, fnInfo)
+		report(pass, rg, origPkgName, origName, chdirName, fnInfo, rws.fixes[rg])
 
 	case geGo124 && a.contextBackground && origPkgName == contextPkgName && origName == backgroundName:
-		report(pass, rg, origPkgName, origName, contextName, fnInfo)
+		report(pass, rg, origPkgName, origName, contextName, fnInfo, rws.fixes[rg])
 
 	case geGo124 && a.contextTodo && origPkgName == contextPkgName && origName == todoName:
-		report(pass, rg, origPkgName, origName, contextName, fnInfo)
+		report(pass, rg, origPkgName, origName, contextName, fnInfo, rws.fixes[rg])
 
 	default:
 		return false
@@ -157,7 +189,7 @@ func (a *analyzer) report(pass *analysis.Pass, rg analysis.Range, origPkgName, o
 	return true
 }
 
-func report(pass *analysis.Pass, rg analysis.Range, origPkgName, origName, expectName string, fnInfo *FuncInfo) {
+func report(pass *analysis.Pass, rg analysis.Range, origPkgName, origName, expectName string, fnInfo *FuncInfo, rw *rewrite) {
 	diagnostic := analysis.Diagnostic{
 		Pos: rg.Pos(),
 		Message: fmt.Sprintf("%s.%s() could be replaced by %s.%s() in %s",
@@ -165,9 +197,25 @@ func report(pass *analysis.Pass, rg analysis.Range, origPkgName, origName, expec
 		),
 	}
 
-	// Skip `<t/b>` arg names.
+	if rw != nil && rw.subject != "" {
+		diagnostic.Pos, diagnostic.End = rw.span.Pos(), rw.span.End()
+		diagnostic.Message = fmt.Sprintf("%s could be replaced by %s.%s() in %s",
+			rw.subject, fnInfo.ArgName, expectName, fnInfo.Name,
+		)
+	}
+
+	switch {
+	case rw != nil && rw.reason != "":
+		diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", rw.reason)
+
+	case rw != nil:
+		diagnostic.Related = rw.related
+		diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+			TextEdits: rw.edits,
+		})
+
 	// Only applies on `context.XXX` because the nb of return parameters is the same as the replacement.
-	if !strings.Contains(fnInfo.ArgName, "<") && origPkgName == contextPkgName {
+	case hasArgName(fnInfo) && origPkgName == contextPkgName:
 		diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
 			TextEdits: []analysis.TextEdit{{
 				Pos:     rg.Pos(),
@@ -180,13 +228,43 @@ func report(pass *analysis.Pass, rg analysis.Range, origPkgName, origName, expec
 	pass.Report(diagnostic)
 }
 
-func isFirstArgEmptyString(ce *ast.CallExpr) bool {
-	bl, ok := ce.Args[0].(*ast.BasicLit)
-	if !ok {
-		return false
+// hasArgName checks if the test argument is named (skip `<t/b>` arg names).
+func hasArgName(fnInfo *FuncInfo) bool {
+	return !strings.Contains(fnInfo.ArgName, "<")
+}
+
+// isSystemTempDir checks if the directory argument resolves to the system temporary directory:
+//
+//	""
+//	emptyConst
+//	os.TempDir()
+//	filepath.Join(os.TempDir(), "foo")
+func isSystemTempDir(pass *analysis.Pass, expr ast.Expr) bool {
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+		return tv.Value.Kind() == constant.String && constant.StringVal(tv.Value) == ""
+	}
+
+	_, ok := findTempDirCall(pass, expr)
+
+	return ok
+}
+
+// findTempDirCall finds the call to `os.TempDir()` of an expression: the call itself, or a path joined to it.
+func findTempDirCall(pass *analysis.Pass, expr ast.Expr) (*ast.CallExpr, bool) {
+	expr = ast.Unparen(expr)
+
+	if call, ok := isFuncCall(pass, expr, osPkgName, tempDirName); ok {
+		return call, true
+	}
+
+	for _, pkgPath := range []string{filepathPkgPath, pathPkgPath} {
+		call, ok := isFuncCall(pass, expr, pkgPath, joinName)
+		if ok && len(call.Args) > 0 && call.Ellipsis == token.NoPos {
+			return findTempDirCall(pass, call.Args[0])
+		}
 	}
 
-	return bl.Kind == token.STRING && bl.Value == `""`
+	return nil, false
 }
 
 func getPkgNameFromType(pass *analysis.Pass, ident *ast.Ident) string {
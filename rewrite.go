@@ -0,0 +1,466 @@
+package usetesting
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// rewrites holds the fixes spanning several statements (error checks, restore idioms, ...).
+// They are computed before walking a test function, and applied when the call is reported.
+type rewrites struct {
+	// fixes indexed by the reported node (the function of the call).
+	fixes map[analysis.Range]*rewrite
+
+	// skipped contains the reported nodes already handled by a rewrite (e.g. a restore call),
+	// or that must not be reported (e.g. a context inside a cleanup function).
+	skipped map[analysis.Range]bool
+}
+
+// rewrite describes the fix of a call and of its surrounding boilerplate.
+type rewrite struct {
+	edits   []analysis.TextEdit
+	related []analysis.RelatedInformation
+
+	// reason explains why there is no fix.
+	reason string
+
+	// subject describes the reported code when the rewrite is about several calls (ex: a temporary directory created by hand),
+	// and span is its range.
+	subject string
+	span    analysis.Range
+}
+
+func newRewrites() *rewrites {
+	return &rewrites{
+		fixes:   make(map[analysis.Range]*rewrite),
+		skipped: make(map[analysis.Range]bool),
+	}
+}
+
+func (a *analyzer) collectRewrites(pass *analysis.Pass, block *ast.BlockStmt, fnInfo *FuncInfo, ignored map[*ast.FuncLit]bool) *rewrites {
+	rws := newRewrites()
+
+	if a.osUnsetenv {
+		// The restore of a variable is not reported.
+		rws.skipEnvRestores(pass, block)
+
+		rws.explain(pass, block, reasonUnknownVariables, osPkgName, clearenvName)
+	}
+
+	if a.osMkdirTemp {
+		rws.explain(pass, block, reasonNotShortVarDecl, osPkgName, mkdirTempName)
+		rws.explain(pass, block, reasonNotShortVarDecl, ioutilPkgPath, tempDirName)
+	}
+
+	// `t.Setenv()` and `t.Chdir()` panic inside parallel tests,
+	// but the calls are still reported: they change the whole process while other tests run.
+	if a.osSetenv && fnInfo.Parallel {
+		rws.explain(pass, block, reasonParallelSetenv, osPkgName, setenvName)
+	}
+
+	if a.osUnsetenv && fnInfo.Parallel {
+		rws.explain(pass, block, reasonParallelSetenv, osPkgName, unsetenvName, clearenvName)
+	}
+
+	if a.osChdir && fnInfo.Parallel {
+		rws.explain(pass, block, reasonParallelChdir, osPkgName, chdirName)
+	}
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		var stmts []ast.Stmt
+
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+		case *ast.BlockStmt:
+			stmts = v.List
+		case *ast.CaseClause:
+			stmts = v.Body
+		case *ast.CommClause:
+			stmts = v.Body
+		default:
+			return true
+		}
+
+		used := make(map[int]bool)
+
+		if a.osSetenv && !fnInfo.Parallel {
+			rws.collectSetenv(pass, stmts, used, fnInfo)
+		}
+
+		if a.osMkdirTemp {
+			rws.collectMkdirTemp(pass, stmts, used, fnInfo)
+		}
+
+		if a.osTempDir {
+			rws.collectTempDir(pass, stmts, used, fnInfo)
+		}
+
+		if a.contextBackground {
+			rws.collectWithCancel(pass, stmts, used, fnInfo, backgroundName)
+		}
+
+		if a.contextTodo {
+			rws.collectWithCancel(pass, stmts, used, fnInfo, todoName)
+		}
+
+		if a.osChdir && !fnInfo.Parallel {
+			rws.collectChdir(pass, stmts, used, fnInfo)
+		}
+
+		if a.osUnsetenv && !fnInfo.Parallel {
+			rws.collectUnsetenv(pass, stmts, used, fnInfo)
+		}
+
+		return true
+	})
+
+	if a.osMkdirTemp {
+		// `t.TempDir()` creates the directory inside the system temporary directory.
+		rws.explainParentDir(pass, block)
+	}
+
+	if !hasArgName(fnInfo) {
+		// The restores are still skipped, but the fixes cannot be written without the name of the testing handle.
+		maps.DeleteFunc(rws.fixes, func(_ analysis.Range, rw *rewrite) bool {
+			return rw.reason == ""
+		})
+	}
+
+	return rws
+}
+
+func (r *rewrites) add(fun ast.Expr, rw *rewrite) {
+	r.fixes[fun] = rw
+}
+
+// explain sets the default reason explaining why the calls cannot be fixed.
+func (r *rewrites) explain(pass *analysis.Pass, node ast.Node, reason, pkgPath string, names ...string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if call, ok := isFuncCall(pass, n, pkgPath, names...); ok {
+			r.add(call.Fun, &rewrite{reason: reason})
+		}
+
+		return true
+	})
+}
+
+func (r *rewrites) skip(pass *analysis.Pass, node ast.Node, pkgPath string, names ...string) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if call, ok := isFuncCall(pass, n, pkgPath, names...); ok {
+			r.skipped[call.Fun] = true
+		}
+
+		return true
+	})
+}
+
+// callStmt is a statement (or a pair of statements) wrapping a call returning only an error.
+type callStmt struct {
+	call *ast.CallExpr
+
+	// first and last are the indexes of the statements covered.
+	first, last int
+
+	// pos and end are the boundaries of the statements covered.
+	pos, end token.Pos
+}
+
+// replace creates the edits replacing the statements by the call of the function fun.
+func (c *callStmt) replace(fun string) []analysis.TextEdit {
+	edits := []analysis.TextEdit{{Pos: c.pos, End: c.call.Fun.End(), NewText: []byte(fun)}}
+
+	if c.call.End() < c.end {
+		edits = append(edits, analysis.TextEdit{Pos: c.call.End(), End: c.end})
+	}
+
+	return edits
+}
+
+// matchCallStmt matches the following shapes:
+//
+//	fn(...)
+//	_ = fn(...)
+//	if err := fn(...); err != nil { ... }
+//	err := fn(...)
+//	if err != nil { ... }
+func matchCallStmt(pass *analysis.Pass, stmts []ast.Stmt, i int, pkgPath string, names ...string) *callStmt {
+	switch stmt := stmts[i].(type) {
+	case *ast.ExprStmt:
+		call, ok := isFuncCall(pass, stmt.X, pkgPath, names...)
+		if !ok {
+			return nil
+		}
+
+		return &callStmt{call: call, first: i, last: i, pos: call.Pos(), end: call.End()}
+
+	case *ast.AssignStmt:
+		if len(stmt.Lhs) != 1 || len(stmt.Rhs) != 1 {
+			return nil
+		}
+
+		call, ok := isFuncCall(pass, stmt.Rhs[0], pkgPath, names...)
+		if !ok {
+			return nil
+		}
+
+		if isBlank(stmt.Lhs[0]) {
+			return &callStmt{call: call, first: i, last: i, pos: stmt.Pos(), end: stmt.End()}
+		}
+
+		if stmt.Tok != token.DEFINE || i+1 >= len(stmts) {
+			return nil
+		}
+
+		errIdent, ok := stmt.Lhs[0].(*ast.Ident)
+		if !ok || !isErrCheck(pass, stmts[i+1], errIdent) {
+			return nil
+		}
+
+		if isUsedIn(pass, pass.TypesInfo.Defs[errIdent], stmts[i+2:]...) {
+			return nil
+		}
+
+		return &callStmt{call: call, first: i, last: i + 1, pos: stmt.Pos(), end: stmts[i+1].End()}
+
+	case *ast.IfStmt:
+		init, ok := stmt.Init.(*ast.AssignStmt)
+		if !ok || init.Tok != token.DEFINE || len(init.Lhs) != 1 || len(init.Rhs) != 1 || stmt.Else != nil {
+			return nil
+		}
+
+		call, ok := isFuncCall(pass, init.Rhs[0], pkgPath, names...)
+		if !ok {
+			return nil
+		}
+
+		errIdent, ok := init.Lhs[0].(*ast.Ident)
+		if !ok || !isErrCond(pass, stmt.Cond, errIdent) {
+			return nil
+		}
+
+		return &callStmt{call: call, first: i, last: i, pos: stmt.Pos(), end: stmt.End()}
+	}
+
+	return nil
+}
+
+// isErrCheck checks if the statement is `if err != nil { ... }` without else branch.
+func isErrCheck(pass *analysis.Pass, stmt ast.Stmt, errIdent *ast.Ident) bool {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil || ifStmt.Else != nil {
+		return false
+	}
+
+	return isErrCond(pass, ifStmt.Cond, errIdent)
+}
+
+// isErrCond checks if the expression is `err != nil`.
+func isErrCond(pass *analysis.Pass, cond ast.Expr, errIdent *ast.Ident) bool {
+	be, ok := cond.(*ast.BinaryExpr)
+	if !ok || be.Op != token.NEQ {
+		return false
+	}
+
+	x, ok := be.X.(*ast.Ident)
+	if !ok || pass.TypesInfo.ObjectOf(x) != pass.TypesInfo.ObjectOf(errIdent) {
+		return false
+	}
+
+	y, ok := be.Y.(*ast.Ident)
+
+	return ok && y.Name == "nil"
+}
+
+// isUsedIn checks if the object is used inside the nodes.
+func isUsedIn(pass *analysis.Pass, obj types.Object, nodes ...ast.Stmt) bool {
+	if obj == nil {
+		return false
+	}
+
+	var used bool
+
+	for _, node := range nodes {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[ident] == obj {
+				used = true
+			}
+
+			return !used
+		})
+	}
+
+	return used
+}
+
+// findRestore finds the statement restoring a state through a deferred call or a cleanup function.
+func findRestore(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, from int, isRestore func(stmts []ast.Stmt) bool) int {
+	for i := max(from, 0); i < len(stmts); i++ {
+		if !used[i] && isRestore(deferredFuncs(pass, stmts[i])) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// isRemovable checks if the objects defined by a statement are only used by the removed statements.
+// The kept expressions are the parts of the removed statements preserved by the fix (e.g. the arguments of a call).
+func isRemovable(pass *analysis.Pass, stmt ast.Stmt, stmts []ast.Stmt, removed []int, kept ...ast.Expr) bool {
+	var others []ast.Stmt
+
+	for i, st := range stmts {
+		if !slices.Contains(removed, i) {
+			others = append(others, st)
+		}
+	}
+
+	for _, expr := range kept {
+		others = append(others, &ast.ExprStmt{X: expr})
+	}
+
+	return !slices.ContainsFunc(definedObjects(pass, stmt), func(obj types.Object) bool {
+		return isUsedIn(pass, obj, others...)
+	})
+}
+
+// definedObjects returns the objects defined by an assignment.
+func definedObjects(pass *analysis.Pass, stmt ast.Stmt) []types.Object {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok {
+		return nil
+	}
+
+	var objs []types.Object
+
+	for _, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if obj := pass.TypesInfo.Defs[ident]; obj != nil {
+			objs = append(objs, obj)
+		}
+	}
+
+	return objs
+}
+
+// isFuncCall checks if the expression is a call to one of the functions of the package.
+// The function can also be a method (ex: `Cleanup` from testing).
+func isFuncCall(pass *analysis.Pass, node ast.Node, pkgPath string, names ...string) (*ast.CallExpr, bool) {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != pkgPath {
+		return nil, false
+	}
+
+	for _, name := range names {
+		if fn.Name() == name {
+			return call, true
+		}
+	}
+
+	return nil, false
+}
+
+// deferredFuncs returns the statements executed by a deferred call or by a cleanup function:
+//
+//	defer fn(...)
+//	defer func() { ... }()
+//	t.Cleanup(func() { ... })
+func deferredFuncs(pass *analysis.Pass, stmt ast.Stmt) []ast.Stmt {
+	switch st := stmt.(type) {
+	case *ast.DeferStmt:
+		if fl, ok := st.Call.Fun.(*ast.FuncLit); ok && len(st.Call.Args) == 0 {
+			return fl.Body.List
+		}
+
+		return []ast.Stmt{&ast.ExprStmt{X: st.Call}}
+
+	case *ast.ExprStmt:
+		call, ok := isFuncCall(pass, st.X, testingPkgName, cleanupName)
+		if !ok || len(call.Args) != 1 {
+			return nil
+		}
+
+		if fl, ok := call.Args[0].(*ast.FuncLit); ok {
+			return fl.Body.List
+		}
+	}
+
+	return nil
+}
+
+// deleteStmt creates an edit removing a statement, and its line when the statement is alone on it.
+func deleteStmt(pass *analysis.Pass, stmt ast.Stmt) analysis.TextEdit {
+	edit := analysis.TextEdit{Pos: stmt.Pos(), End: stmt.End()}
+
+	file := pass.Fset.File(stmt.Pos())
+
+	content, err := pass.ReadFile(file.Name())
+	if err != nil {
+		return edit
+	}
+
+	start, end := file.Offset(stmt.Pos()), file.Offset(stmt.End())
+
+	for start > 0 && isSpace(content[start-1]) {
+		start--
+	}
+
+	for end < len(content) && isSpace(content[end]) {
+		end++
+	}
+
+	if (start == 0 || content[start-1] == '\n') && end < len(content) && content[end] == '\n' {
+		edit.Pos = file.Pos(start)
+		edit.End = file.Pos(end + 1)
+	}
+
+	return edit
+}
+
+// lineIndent returns the indentation of the line containing the position.
+func lineIndent(pass *analysis.Pass, pos token.Pos) string {
+	file := pass.Fset.File(pos)
+
+	content, err := pass.ReadFile(file.Name())
+	if err != nil {
+		return ""
+	}
+
+	start := file.Offset(file.LineStart(file.Line(pos)))
+
+	end := start
+	for end < len(content) && isSpace(content[end]) {
+		end++
+	}
+
+	return string(content[start:end])
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t'
+}
+
+func isBlank(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == "_"
+}
+
+func sameExpr(a, b ast.Expr) bool {
+	return types.ExprString(a) == types.ExprString(b)
+}
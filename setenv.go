@@ -0,0 +1,241 @@
+package usetesting
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// collectSetenv computes the fixes of `os.Setenv` calls:
+//
+//	old := os.Getenv("FOO")
+//	if err := os.Setenv("FOO", "bar"); err != nil {
+//		t.Fatal(err)
+//	}
+//	defer os.Setenv("FOO", old)
+//
+// becomes:
+//
+//	t.Setenv("FOO", "bar")
+func (r *rewrites) collectSetenv(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, fnInfo *FuncInfo) {
+	for i := range stmts {
+		if used[i] {
+			continue
+		}
+
+		cs := matchCallStmt(pass, stmts, i, osPkgName, setenvName)
+		if cs == nil || len(cs.call.Args) != 2 {
+			continue
+		}
+
+		rw := &rewrite{edits: cs.replace(fnInfo.ArgName + "." + setenvName)}
+
+		for j := cs.first; j <= cs.last; j++ {
+			used[j] = true
+		}
+
+		r.add(cs.call.Fun, rw)
+
+		isKey := func(expr ast.Expr) bool {
+			return isSameEnvKey(pass, cs.call.Args[0], expr, stmts)
+		}
+
+		saveIdx, saved := findEnvSave(pass, stmts[:cs.first], used, isKey)
+
+		restoreIdx := findRestore(pass, stmts, used, saveIdx+1, func(stmts []ast.Stmt) bool {
+			return isEnvRestore(pass, stmts, isKey, saved)
+		})
+		if restoreIdx < 0 {
+			continue
+		}
+
+		used[restoreIdx] = true
+
+		restore := stmts[restoreIdx]
+
+		rw.edits = append(rw.edits, deleteStmt(pass, restore))
+		rw.related = append(rw.related, analysis.RelatedInformation{
+			Pos:     restore.Pos(),
+			End:     restore.End(),
+			Message: "the original value is restored here",
+		})
+
+		r.skip(pass, restore, osPkgName, setenvName, unsetenvName)
+
+		if saveIdx < 0 {
+			continue
+		}
+
+		removed := []int{saveIdx, restoreIdx}
+		for j := cs.first; j <= cs.last; j++ {
+			removed = append(removed, j)
+		}
+
+		if isRemovable(pass, stmts[saveIdx], stmts, removed, cs.call.Args...) {
+			used[saveIdx] = true
+
+			rw.edits = append(rw.edits, deleteStmt(pass, stmts[saveIdx]))
+		}
+	}
+}
+
+// findEnvSave finds the last statement saving the value of the environment variable:
+//
+//	old := os.Getenv("FOO")
+//	old, ok := os.LookupEnv("FOO")
+func findEnvSave(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, isKey func(ast.Expr) bool) (int, []types.Object) {
+	for i := len(stmts) - 1; i >= 0; i-- {
+		if used[i] {
+			continue
+		}
+
+		assign, ok := stmts[i].(*ast.AssignStmt)
+		if !ok || len(assign.Rhs) != 1 {
+			continue
+		}
+
+		call, ok := isFuncCall(pass, assign.Rhs[0], osPkgName, getenvName, lookupEnvName)
+		if !ok || len(call.Args) != 1 || !isKey(call.Args[0]) {
+			continue
+		}
+
+		var saved []types.Object
+
+		for _, lhs := range assign.Lhs {
+			ident, ok := lhs.(*ast.Ident)
+			if !ok {
+				return -1, nil
+			}
+
+			if obj := pass.TypesInfo.Defs[ident]; obj != nil {
+				saved = append(saved, obj)
+			}
+		}
+
+		if len(saved) == 0 {
+			return -1, nil
+		}
+
+		return i, saved
+	}
+
+	return -1, nil
+}
+
+// isEnvRestore checks if the statements only restore the environment variable:
+//
+//	os.Setenv("FOO", old)
+//	os.Unsetenv("FOO")
+//	if ok { os.Setenv("FOO", old) } else { os.Unsetenv("FOO") }
+func isEnvRestore(pass *analysis.Pass, stmts []ast.Stmt, isKey func(ast.Expr) bool, saved []types.Object) bool {
+	if len(stmts) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(stmts); i++ {
+		cs := matchCallStmt(pass, stmts, i, osPkgName, setenvName, unsetenvName)
+		if cs != nil {
+			if len(cs.call.Args) == 0 || !isKey(cs.call.Args[0]) {
+				return false
+			}
+
+			if len(cs.call.Args) == 2 && !isSavedValue(pass, cs.call.Args[1], saved) {
+				return false
+			}
+
+			i = cs.last
+
+			continue
+		}
+
+		switch stmt := stmts[i].(type) {
+		case *ast.IfStmt:
+			if stmt.Init != nil || !isEnvRestore(pass, stmt.Body.List, isKey, saved) {
+				return false
+			}
+
+			if stmt.Else != nil && !isEnvRestore(pass, []ast.Stmt{stmt.Else}, isKey, saved) {
+				return false
+			}
+
+		case *ast.BlockStmt:
+			if !isEnvRestore(pass, stmt.List, isKey, saved) {
+				return false
+			}
+
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSavedValue(pass *analysis.Pass, expr ast.Expr, saved []types.Object) bool {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	return slices.Contains(saved, pass.TypesInfo.Uses[ident])
+}
+
+// isSameEnvKey checks if the expressions are the same environment variable:
+// the same constant, or the same variable not reassigned by the statements.
+func isSameEnvKey(pass *analysis.Pass, a, b ast.Expr, stmts []ast.Stmt) bool {
+	tva, tvb := pass.TypesInfo.Types[a], pass.TypesInfo.Types[b]
+	if tva.Value != nil || tvb.Value != nil {
+		return tva.Value != nil && tvb.Value != nil && constant.Compare(tva.Value, token.EQL, tvb.Value)
+	}
+
+	identA, ok := ast.Unparen(a).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	identB, ok := ast.Unparen(b).(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	v, ok := pass.TypesInfo.Uses[identA].(*types.Var)
+	if !ok || v != pass.TypesInfo.Uses[identB] {
+		return false
+	}
+
+	return !isReassigned(pass, v, stmts)
+}
+
+// isReassigned checks if the variable is assigned, or if its address is taken, by the statements.
+func isReassigned(pass *analysis.Pass, v *types.Var, stmts []ast.Stmt) bool {
+	var reassigned bool
+
+	isVar := func(expr ast.Expr) bool {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+
+		return ok && pass.TypesInfo.Uses[ident] == v
+	}
+
+	for _, stmt := range stmts {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			switch st := n.(type) {
+			case *ast.AssignStmt:
+				reassigned = reassigned || slices.ContainsFunc(st.Lhs, isVar)
+
+			case *ast.RangeStmt:
+				reassigned = reassigned || (st.Tok == token.ASSIGN && (isVar(st.Key) || isVar(st.Value)))
+
+			case *ast.UnaryExpr:
+				reassigned = reassigned || (st.Op == token.AND && isVar(st.X))
+			}
+
+			return !reassigned
+		})
+	}
+
+	return reassigned
+}
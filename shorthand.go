@@ -0,0 +1,132 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// shorthand describes a pair of testing calls replaced by a single call.
+type shorthand struct {
+	// stop is the method stopping the test after the first call.
+	stop string
+
+	// replacement is the method combining the two calls.
+	replacement string
+}
+
+// shorthands are the testing methods combining a call and `FailNow`/`SkipNow`, indexed by the name of the first call.
+var shorthands = map[string]shorthand{
+	errorName:  {stop: failNowName, replacement: fatalName},
+	errorfName: {stop: failNowName, replacement: fatalfName},
+	logName:    {stop: skipNowName, replacement: skipName},
+	logfName:   {stop: skipNowName, replacement: skipfName},
+}
+
+// reportShorthand reports the consecutive calls having a shorthand in the testing package:
+//
+//	t.Errorf("foo: %v", err)
+//	t.FailNow()
+//
+//	t.Log("foo")
+//	t.SkipNow()
+//
+// becomes:
+//
+//	t.Fatalf("foo: %v", err)
+//
+//	t.Skip("foo")
+func reportShorthand(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	ast.Inspect(block, func(n ast.Node) bool {
+		var stmts []ast.Stmt
+
+		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+		case *ast.BlockStmt:
+			stmts = v.List
+		case *ast.CaseClause:
+			stmts = v.Body
+		case *ast.CommClause:
+			stmts = v.Body
+		default:
+			return true
+		}
+
+		for i := 1; i < len(stmts); i++ {
+			reportShorthandPair(pass, stmts[i-1], stmts[i], fnInfo)
+		}
+
+		return true
+	})
+}
+
+func reportShorthandPair(pass *analysis.Pass, first, second ast.Stmt, fnInfo *FuncInfo) {
+	call, se, ok := testingMethodCall(pass, first)
+	if !ok {
+		return
+	}
+
+	name := typeutil.Callee(pass.TypesInfo, call).Name()
+
+	sh, ok := shorthands[name]
+	if !ok {
+		return
+	}
+
+	stop, stopSe, ok := testingMethodCall(pass, second)
+	if !ok || len(stop.Args) != 0 || typeutil.Callee(pass.TypesInfo, stop).Name() != sh.stop || !sameExpr(se.X, stopSe.X) {
+		return
+	}
+
+	recv := types.ExprString(se.X)
+
+	diagnostic := analysis.Diagnostic{
+		Pos: first.Pos(),
+		End: second.End(),
+		Message: fmt.Sprintf("%s.%s() and %s.%s() could be replaced by %s.%s() in %s",
+			recv, name, recv, sh.stop, recv, sh.replacement, fnInfo.Name,
+		),
+	}
+
+	diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+		TextEdits: []analysis.TextEdit{
+			{
+				Pos:     se.Sel.Pos(),
+				End:     se.Sel.End(),
+				NewText: []byte(sh.replacement),
+			},
+			deleteStmt(pass, second),
+		},
+	})
+
+	pass.Report(diagnostic)
+}
+
+// testingMethodCall checks if the statement is a call to a method of the testing package (ex: `t.Error()`).
+func testingMethodCall(pass *analysis.Pass, stmt ast.Stmt) (*ast.CallExpr, *ast.SelectorExpr, bool) {
+	es, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, nil, false
+	}
+
+	call, ok := es.X.(*ast.CallExpr)
+	if !ok {
+		return nil, nil, false
+	}
+
+	se, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, nil, false
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != testingPkgName {
+		return nil, nil, false
+	}
+
+	return call, se, true
+}
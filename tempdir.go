@@ -0,0 +1,81 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// collectTempDir computes the fixes of the temporary directories created inside `os.TempDir()`:
+//
+//	dir := filepath.Join(os.TempDir(), t.Name())
+//	if err := os.MkdirAll(dir, 0o755); err != nil {
+//		t.Fatal(err)
+//	}
+//	defer os.RemoveAll(dir)
+//
+// becomes:
+//
+//	dir := t.TempDir()
+func (r *rewrites) collectTempDir(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, fnInfo *FuncInfo) {
+	for i := 0; i+1 < len(stmts); i++ {
+		if used[i] || used[i+1] {
+			continue
+		}
+
+		assign, ok := stmts[i].(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			continue
+		}
+
+		dirIdent, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok || isBlank(dirIdent) {
+			continue
+		}
+
+		call, ok := findTempDirCall(pass, assign.Rhs[0])
+		if !ok {
+			continue
+		}
+
+		cs := matchCallStmt(pass, stmts, i+1, osPkgName, mkdirAllName, mkdirName)
+		if cs == nil || len(cs.call.Args) != 2 {
+			continue
+		}
+
+		ident, ok := cs.call.Args[0].(*ast.Ident)
+		if !ok || pass.TypesInfo.Uses[ident] != pass.TypesInfo.ObjectOf(dirIdent) {
+			continue
+		}
+
+		for j := i; j <= cs.last; j++ {
+			used[j] = true
+		}
+
+		tok := token.DEFINE
+		if assign.Tok != token.DEFINE || pass.TypesInfo.Defs[dirIdent] == nil {
+			tok = token.ASSIGN
+		}
+
+		rw := &rewrite{
+			edits: []analysis.TextEdit{{
+				Pos:     assign.Pos(),
+				End:     cs.end,
+				NewText: []byte(dirIdent.Name + " " + tok.String() + " " + fnInfo.ArgName + "." + tempDirName + "()"),
+			}},
+			subject: fmt.Sprintf("temporary directory created with %s.%s()+%s.%s()",
+				osPkgName, tempDirName, osPkgName, typeutil.Callee(pass.TypesInfo, cs.call).Name(),
+			),
+			span: assign,
+		}
+
+		r.add(call.Fun, rw)
+
+		removeDirCleanup(pass, stmts[cs.last+1:], cs.last+1, used, dirIdent, rw)
+
+		i = cs.last
+	}
+}
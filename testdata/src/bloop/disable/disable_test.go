@@ -0,0 +1,105 @@
+package disable
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func Benchmark_For(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Range(b *testing.B) {
+	for range b.N {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_ResetTimer(b *testing.B) {
+	s := strconv.Itoa(42)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = strconv.Atoi(s)
+	}
+}
+
+func Benchmark_LoopVarUsed(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = strconv.Itoa(i)
+	}
+}
+
+func Benchmark_RangeVarUsed(b *testing.B) {
+	for i := range b.N {
+		_ = strconv.Itoa(i)
+	}
+}
+
+func Benchmark_SeveralLoops(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+
+	for i := 0; i < b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_SubBenchmark(b *testing.B) {
+	b.Run("foo", func(b *testing.B) {
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_ = strconv.Itoa(42)
+		}
+	})
+}
+
+func Benchmark_Loop(b *testing.B) {
+	for b.Loop() {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Other(b *testing.B) {
+	for i := 1; i < b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+
+	for i := 0; i <= b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+
+	for i := 0; i < b.N; i += 2 {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_NoName(_ *testing.B) {}
+
+func Test_N(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func bar(n int) {
+	for i := 0; i < n; i++ {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Nested(b *testing.B) {
+	for _, size := range []int{10, 100} {
+		s := strings.Repeat("a", size)
+
+		for i := 0; i < b.N; i++ {
+			_ = strings.ToUpper(s)
+		}
+	}
+}
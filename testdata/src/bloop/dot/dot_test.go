@@ -0,0 +1,20 @@
+package dot
+
+import (
+	"strconv"
+	. "testing"
+)
+
+func Benchmark_For(b *B) {
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_For`
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Loop(b *B) {
+	for b.Loop() {
+		_ = strconv.Itoa(42)
+	}
+}
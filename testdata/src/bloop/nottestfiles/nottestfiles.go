@@ -0,0 +1,105 @@
+package nottestfiles
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func Benchmark_For(b *testing.B) {
+	for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_For`
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Range(b *testing.B) {
+	for range b.N { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_Range`
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_ResetTimer(b *testing.B) {
+	s := strconv.Itoa(42)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_ResetTimer`
+		_, _ = strconv.Atoi(s)
+	}
+}
+
+func Benchmark_LoopVarUsed(b *testing.B) {
+	for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_LoopVarUsed \(no suggested fix: the loop variable is used\)`
+		_ = strconv.Itoa(i)
+	}
+}
+
+func Benchmark_RangeVarUsed(b *testing.B) {
+	for i := range b.N { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_RangeVarUsed \(no suggested fix: the loop variable is used\)`
+		_ = strconv.Itoa(i)
+	}
+}
+
+func Benchmark_SeveralLoops(b *testing.B) {
+	for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_SeveralLoops \(no suggested fix: the benchmark has several loops over b\.N\)`
+		_ = strconv.Itoa(42)
+	}
+
+	for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_SeveralLoops \(no suggested fix: the benchmark has several loops over b\.N\)`
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_SubBenchmark(b *testing.B) {
+	b.Run("foo", func(b *testing.B) {
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_SubBenchmark/foo`
+			_ = strconv.Itoa(42)
+		}
+	})
+}
+
+func Benchmark_Loop(b *testing.B) {
+	for b.Loop() {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Other(b *testing.B) {
+	for i := 1; i < b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+
+	for i := 0; i <= b.N; i++ {
+		_ = strconv.Itoa(42)
+	}
+
+	for i := 0; i < b.N; i += 2 {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_NoName(_ *testing.B) {}
+
+func Test_N(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func bar(n int) {
+	for i := 0; i < n; i++ {
+		_ = strconv.Itoa(42)
+	}
+}
+
+func Benchmark_Nested(b *testing.B) {
+	for _, size := range []int{10, 100} {
+		s := strings.Repeat("a", size)
+
+		for i := 0; i < b.N; i++ { // want `loop over b\.N could be replaced by b\.Loop\(\) in Benchmark_Nested \(no suggested fix: the loop is inside another loop\)`
+			_ = strings.ToUpper(s)
+		}
+	}
+}
@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+		context.Background()
 	}
 }
 
 func bir(t *testing.T) func() {
 	context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
 	return func() {
-		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+		context.Background()
 	}
 }
 
@@ -94,7 +94,7 @@ func Test_CallExpr_deep(t *testing.T) {
 
 func Test_GoStmt(t *testing.T) {
 	go func() {
-		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+		context.Background()
 	}()
 }
 
@@ -156,7 +156,7 @@ func Test_SelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+				context.Background()
 			}
 		}
 	}()
@@ -176,7 +176,7 @@ func Test_SelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+					context.Background()
 				}()
 			}
 		}
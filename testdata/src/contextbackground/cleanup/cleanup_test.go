@@ -0,0 +1,42 @@
+package cleanup
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_Cleanup(t *testing.T) {
+	context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+
+	t.Cleanup(func() {
+		context.Background()
+
+		func() {
+			context.Background()
+		}()
+	})
+}
+
+func Test_Cleanup_variable(t *testing.T) {
+	fn := func() {
+		context.Background()
+	}
+
+	t.Cleanup(fn)
+}
+
+func Test_Cleanup_subtest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		t.Cleanup(func() {
+			context.Background()
+		})
+
+		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	})
+}
+
+func Test_Defer(t *testing.T) {
+	defer func() {
+		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	}()
+}
@@ -0,0 +1,94 @@
+package derived
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"testing"
+	"time"
+)
+
+func Test_WithCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_WithCancel_func(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer func() {
+		cancel()
+	}()
+
+	_ = ctx
+}
+
+func Test_WithCancel_blank(t *testing.T) {
+	ctx, _ := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+
+	_ = ctx
+}
+
+func Test_WithCancel_redeclared(t *testing.T) {
+	var ctx context.Context
+
+	ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_WithCancel_called(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	cancel()
+
+	_ = ctx
+}
+
+func Test_WithCancel_no_defer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+
+	_ = ctx
+
+	cancel()
+}
+
+func Test_WithTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_WithDeadline(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(time.Second)) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_NotifyContext(t *testing.T) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt) // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	defer stop()
+
+	_ = ctx
+}
+
+func helper(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in helper \(no suggested fix: the context is canceled when the function returns, and not at the end of the test\)`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_WithCancel_subtest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background()) // want `context\.Background\(\) could be replaced by t\.Context\(\) in Test_WithCancel_subtest/foo`
+		defer cancel()
+
+		_ = ctx
+	})
+}
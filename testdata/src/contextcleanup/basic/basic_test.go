@@ -0,0 +1,78 @@
+package basic
+
+import (
+	"context"
+	"testing"
+)
+
+type helper struct {
+	t *testing.T
+}
+
+func Test_Cleanup(t *testing.T) {
+	t.Cleanup(func() {
+		_ = t.Context() // want `t\.Context\(\) could be replaced by context\.Background\(\) in Test_Cleanup: the context is canceled before the cleanup functions run`
+	})
+}
+
+func Test_Cleanup_variable(t *testing.T) {
+	fn := func() {
+		_ = t.Context() // want `t\.Context\(\) could be replaced by context\.Background\(\) in .+`
+	}
+
+	t.Cleanup(fn)
+}
+
+func Test_Cleanup_nested(t *testing.T) {
+	t.Cleanup(func() {
+		t.Cleanup(func() {
+			_ = t.Context() // want `t\.Context\(\) could be replaced by context\.Background\(\) in .+`
+		})
+	})
+}
+
+func Test_Cleanup_subtest(t *testing.T) {
+	t.Run("foo", func(st *testing.T) {
+		st.Cleanup(func() {
+			_ = st.Context() // want `st\.Context\(\) could be replaced by context\.Background\(\) in Test_Cleanup_subtest/foo`
+		})
+	})
+}
+
+func Test_Cleanup_parent(t *testing.T) {
+	t.Run("foo", func(st *testing.T) {
+		st.Cleanup(func() {
+			_ = t.Context()
+		})
+	})
+}
+
+func Benchmark_Cleanup(b *testing.B) {
+	b.Cleanup(func() {
+		_ = b.Context() // want `b\.Context\(\) could be replaced by context\.Background\(\) in .+`
+	})
+}
+
+func Test_Cleanup_TB(t *testing.T) {
+	var tb testing.TB = t
+
+	tb.Cleanup(func() {
+		_ = tb.Context() // want `tb\.Context\(\) could be replaced by context\.Background\(\) in .+`
+	})
+}
+
+func (h *helper) cleanup() {
+	h.t.Cleanup(func() {
+		_ = h.t.Context() // want `h\.t\.Context\(\) could be replaced by context\.Background\(\) in cleanup`
+	})
+}
+
+func Test_Context(t *testing.T) {
+	_ = t.Context()
+
+	defer func() {
+		_ = t.Context()
+	}()
+
+	_ = context.Background()
+}
@@ -0,0 +1,11 @@
+package basic
+
+import (
+	"testing"
+)
+
+func Test_Cleanup_no_import(t *testing.T) {
+	t.Cleanup(func() {
+		_ = t.Context() // want `t\.Context\(\) could be replaced by context\.Background\(\) in .+`
+	})
+}
@@ -0,0 +1,17 @@
+package dot
+
+import (
+	. "context"
+	"testing"
+)
+
+func Test_Cleanup(t *testing.T) {
+	t.Cleanup(func() {
+		_ = t.Context() // want `t\.Context\(\) could be replaced by context\.Background\(\) in .+`
+	})
+}
+
+func Test_Context(t *testing.T) {
+	_ = t.Context()
+	_ = Background()
+}
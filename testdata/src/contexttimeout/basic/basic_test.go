@@ -0,0 +1,90 @@
+package basic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+const timeout = 10 * time.Minute
+
+func Test_WithTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute) // want `context\.WithTimeout\(\) could derive its timeout from t\.Deadline\(\) in Test_WithTimeout`
+	defer cancel()
+	_ = ctx
+}
+
+func Test_WithTimeoutCause(t *testing.T) {
+	ctx, cancel := context.WithTimeoutCause(context.Background(), time.Second, errors.New("timeout")) // want `context\.WithTimeoutCause\(\) could derive its timeout from t\.Deadline\(\) in Test_WithTimeoutCause`
+	defer cancel()
+	_ = ctx
+}
+
+func Test_Const(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout) // want `context\.WithTimeout\(\) could derive its timeout from t\.Deadline\(\) in Test_Const`
+	defer cancel()
+	_ = ctx
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout) // want `context\.WithTimeout\(\) could derive its timeout from t\.Deadline\(\) in Test_SubTest/foo`
+		defer cancel()
+		_ = ctx
+	})
+}
+
+func Test_NoName(_ *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout) // want `context\.WithTimeout\(\) could derive its timeout from <t/b>\.Deadline\(\) in Test_NoName`
+	defer cancel()
+	_ = ctx
+}
+
+func Test_Deadline(t *testing.T) {
+	d := timeout
+	if deadline, ok := t.Deadline(); ok {
+		d = time.Until(deadline)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	_ = ctx
+}
+
+func Test_WithDeadline(t *testing.T) {
+	deadline, ok := t.Deadline()
+	if !ok {
+		deadline = time.Now().Add(timeout)
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	_ = ctx
+}
+
+func Test_Escape(t *testing.T) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		_ = ctx
+	}()
+}
+
+func Benchmark_WithTimeout(b *testing.B) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	_ = ctx
+}
+
+func tbHelper(tb testing.TB) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	_ = ctx
+}
+
+func bar() {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	_ = ctx
+}
@@ -0,0 +1,24 @@
+package dot
+
+import (
+	. "context"
+	"testing"
+	"time"
+)
+
+func Test_WithTimeout(t *testing.T) {
+	ctx, cancel := WithTimeout(Background(), 10*time.Minute) // want `context\.WithTimeout\(\) could derive its timeout from t\.Deadline\(\) in Test_WithTimeout`
+	defer cancel()
+	_ = ctx
+}
+
+func Test_Deadline(t *testing.T) {
+	d := 10 * time.Minute
+	if deadline, ok := t.Deadline(); ok {
+		d = time.Until(deadline)
+	}
+
+	ctx, cancel := WithTimeout(Background(), d)
+	defer cancel()
+	_ = ctx
+}
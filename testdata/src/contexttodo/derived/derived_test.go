@@ -0,0 +1,37 @@
+package derived
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func Test_WithCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO()) // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_WithTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second) // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+	defer cancel()
+
+	_ = ctx
+}
+
+func helper(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO()) // want `context\.TODO\(\) could be replaced by t\.Context\(\) in helper \(no suggested fix: the context is canceled when the function returns, and not at the end of the test\)`
+	defer cancel()
+
+	_ = ctx
+}
+
+func Test_WithCancel_subtest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO()) // want `context\.TODO\(\) could be replaced by t\.Context\(\) in Test_WithCancel_subtest/foo`
+		defer cancel()
+
+		_ = ctx
+	})
+}
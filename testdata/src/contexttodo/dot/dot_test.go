@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+		TODO()
 	}
 }
 
 func bir(t *testing.T) func() {
 	TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
 	return func() {
-		TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+		TODO()
 	}
 }
 
@@ -94,7 +94,7 @@ func Test_CallExpr_deep(t *testing.T) {
 
 func Test_GoStmt(t *testing.T) {
 	go func() {
-		TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+		TODO()
 	}()
 }
 
@@ -156,7 +156,7 @@ func Test_SelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+				TODO()
 			}
 		}
 	}()
@@ -176,7 +176,7 @@ func Test_SelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+					TODO()
 				}()
 			}
 		}
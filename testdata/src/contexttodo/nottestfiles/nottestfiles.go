@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		context.TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+		context.TODO()
 	}
 }
 
 func bir(t *testing.T) func() {
 	context.TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
 	return func() {
-		context.TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+		context.TODO()
 	}
 }
 
@@ -98,7 +98,7 @@ func FunctionCallExpr_deep(t *testing.T) {
 
 func FunctionGoStmt(t *testing.T) {
 	go func() {
-		context.TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+		context.TODO()
 	}()
 }
 
@@ -160,7 +160,7 @@ func FunctionSelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				context.TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+				context.TODO()
 			}
 		}
 	}()
@@ -180,7 +180,7 @@ func FunctionSelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					context.TODO() // want `context\.TODO\(\) could be replaced by t\.Context\(\) in .+`
+					context.TODO()
 				}()
 			}
 		}
@@ -0,0 +1,70 @@
+package basic
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_Print(t *testing.T) {
+	fmt.Print("foo") // want `fmt\.Print\(\) could be replaced by t\.Log\(\) in Test_Print`
+}
+
+func Test_Printf(t *testing.T) {
+	fmt.Printf("foo %s\n", "bar") // want `fmt\.Printf\(\) could be replaced by t\.Logf\(\) in Test_Printf`
+}
+
+func Test_Println(t *testing.T) {
+	fmt.Println("foo", 42) // want `fmt\.Println\(\) could be replaced by t\.Log\(\) in Test_Println`
+}
+
+func Test_ResultUsed(t *testing.T) {
+	_, err := fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by t\.Log\(\) in Test_ResultUsed \(no suggested fix: the result is used\)`
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_Defer(t *testing.T) {
+	defer fmt.Println("done") // want `fmt\.Println\(\) could be replaced by t\.Log\(\) in Test_Defer`
+}
+
+func Test_Goroutine(t *testing.T) {
+	go fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by t\.Log\(\) in Test_Goroutine`
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by t\.Log\(\) in Test_SubTest/foo`
+	})
+}
+
+func Test_NoName(_ *testing.T) {
+	fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by <t/b>\.Log\(\) in Test_NoName`
+}
+
+func Benchmark_Println(b *testing.B) {
+	fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by b\.Log\(\) in Benchmark_Println`
+}
+
+func FuzzPrintln(f *testing.F) {
+	fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by f\.Log\(\) in FuzzPrintln`
+}
+
+func helper(tb testing.TB) {
+	fmt.Println("foo") // want `fmt\.Println\(\) could be replaced by tb\.Log\(\) in helper`
+}
+
+func Test_Other(t *testing.T) {
+	s := fmt.Sprintf("foo %s", "bar")
+	t.Log(s)
+}
+
+func Test_Escape(t *testing.T) {
+	go func() {
+		fmt.Println("foo")
+	}()
+}
+
+func bar() {
+	fmt.Println("foo")
+}
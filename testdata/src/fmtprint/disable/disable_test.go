@@ -0,0 +1,62 @@
+package disable
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_Print(t *testing.T) {
+	fmt.Print("foo")
+}
+
+func Test_Printf(t *testing.T) {
+	fmt.Printf("foo %s\n", "bar")
+}
+
+func Test_Println(t *testing.T) {
+	fmt.Println("foo", 42)
+}
+
+func Test_ResultUsed(t *testing.T) {
+	_, err := fmt.Println("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		fmt.Println("foo")
+	})
+}
+
+func Test_NoName(_ *testing.T) {
+	fmt.Println("foo")
+}
+
+func Benchmark_Println(b *testing.B) {
+	fmt.Println("foo")
+}
+
+func FuzzPrintln(f *testing.F) {
+	fmt.Println("foo")
+}
+
+func helper(tb testing.TB) {
+	fmt.Println("foo")
+}
+
+func Test_Other(t *testing.T) {
+	s := fmt.Sprintf("foo %s", "bar")
+	t.Log(s)
+}
+
+func Test_Escape(t *testing.T) {
+	go func() {
+		fmt.Println("foo")
+	}()
+}
+
+func bar() {
+	fmt.Println("foo")
+}
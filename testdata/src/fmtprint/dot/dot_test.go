@@ -0,0 +1,14 @@
+package dot
+
+import (
+	. "fmt"
+	"testing"
+)
+
+func Test_Println(t *testing.T) {
+	Println("foo") // want `fmt\.Println\(\) could be replaced by t\.Log\(\) in Test_Println`
+}
+
+func Test_Printf(t *testing.T) {
+	Printf("foo %s\n", "bar") // want `fmt\.Printf\(\) could be replaced by t\.Logf\(\) in Test_Printf`
+}
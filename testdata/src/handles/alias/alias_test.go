@@ -0,0 +1,53 @@
+package alias
+
+import (
+	"handles/testutil"
+	"os"
+	tst "testing"
+)
+
+func Test_import_alias(t *tst.T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+}
+
+func Benchmark_import_alias(b *tst.B) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by b\.TempDir\(\) in .+`
+}
+
+func Fuzz_import_alias(f *tst.F) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by f\.TempDir\(\) in .+`
+}
+
+func helper_import_alias(tb tst.TB) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in .+`
+}
+
+func Test_type_alias(t *testutil.T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+}
+
+func Benchmark_type_alias(b *testutil.B) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by b\.TempDir\(\) in .+`
+}
+
+func helper_type_alias(tb testutil.TB) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in .+`
+}
+
+func helper_not_alias(t *testutil.NotT) {
+	os.MkdirTemp("", "")
+}
+
+func helper_not_pointer(t tst.T) {
+	os.MkdirTemp("", "")
+}
+
+func helper_pointer_tb(tb *tst.TB) {
+	os.MkdirTemp("", "")
+}
+
+type T struct{}
+
+func helper_local_type(t *T) {
+	os.MkdirTemp("", "")
+}
@@ -0,0 +1,26 @@
+package dot
+
+import (
+	"os"
+	. "testing"
+)
+
+func Test_dot(t *T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+}
+
+func Benchmark_dot(b *B) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by b\.TempDir\(\) in .+`
+}
+
+func Fuzz_dot(f *F) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by f\.TempDir\(\) in .+`
+}
+
+func helper_dot(tb TB) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in .+`
+}
+
+func Fuzz_NoName(_ *F) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by <f>\.TempDir\(\) in .+`
+}
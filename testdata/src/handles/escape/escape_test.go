@@ -0,0 +1,113 @@
+package escape
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+)
+
+var hook func()
+
+func returned(t *testing.T) func() {
+	return func() {
+		context.Background()
+	}
+}
+
+func returnedVariable(t *testing.T) func() {
+	fn := func() {
+		context.Background()
+	}
+
+	return fn
+}
+
+func returnedNested(t *testing.T) func() {
+	return func() {
+		func() {
+			os.MkdirTemp("", "")
+		}()
+	}
+}
+
+func Test_PackageVar(t *testing.T) {
+	hook = func() {
+		context.Background()
+	}
+}
+
+func Test_Send(t *testing.T) {
+	ch := make(chan func(), 1)
+
+	ch <- func() {
+		context.Background()
+	}
+}
+
+func Test_GoStmt(t *testing.T) {
+	go func() {
+		os.MkdirTemp("", "")
+	}()
+}
+
+func Test_GoStmt_variable(t *testing.T) {
+	fn := func() {
+		context.Background()
+	}
+
+	go fn()
+}
+
+func Test_GoStmt_WaitGroup(t *testing.T) {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	}()
+
+	wg.Wait()
+}
+
+func Test_GoStmt_WaitGroup_deferred(t *testing.T) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+	}()
+}
+
+func Test_GoStmt_channel(t *testing.T) {
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	}()
+
+	<-done
+}
+
+func Test_Call(t *testing.T) {
+	fn := func() {
+		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	}
+
+	fn()
+}
+
+func subtest(t *testing.T) func(*testing.T) {
+	return func(t *testing.T) {
+		context.Background() // want `context\.Background\(\) could be replaced by t\.Context\(\) in .+`
+	}
+}
@@ -0,0 +1,53 @@
+package field
+
+import (
+	"os"
+	"testing"
+)
+
+type harness struct {
+	name string
+	t    *testing.T
+}
+
+func (h *harness) setup() {
+	os.MkdirTemp("", h.name) // want `os\.MkdirTemp\(\) could be replaced by h\.t\.TempDir\(\) in setup`
+}
+
+func (h harness) valueReceiver() {
+	os.MkdirTemp("", h.name) // want `os\.MkdirTemp\(\) could be replaced by h\.t\.TempDir\(\) in valueReceiver`
+}
+
+func (*harness) noName() {
+	os.MkdirTemp("", "")
+}
+
+func (h *harness) withParam(tb testing.TB) {
+	os.MkdirTemp("", h.name) // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in withParam`
+}
+
+func (h *harness) subtest() {
+	h.t.Run("sub", func(t *testing.T) {
+		os.MkdirTemp("", h.name) // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in subtest/sub`
+	})
+}
+
+func setupHarness(h *harness) {
+	os.MkdirTemp("", h.name) // want `os\.MkdirTemp\(\) could be replaced by h\.t\.TempDir\(\) in setupHarness`
+}
+
+type embedded struct {
+	testing.TB
+}
+
+func (e embedded) setup() {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by e\.TB\.TempDir\(\) in setup`
+}
+
+type noHandle struct {
+	name string
+}
+
+func (n *noHandle) setup() {
+	os.MkdirTemp("", n.name)
+}
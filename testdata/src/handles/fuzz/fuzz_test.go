@@ -0,0 +1,45 @@
+package fuzz
+
+import (
+	"os"
+	"testing"
+)
+
+func FuzzSetup(f *testing.F) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by f\.TempDir\(\) in FuzzSetup`
+
+	f.Add([]byte("a"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in anonymous function`
+	})
+}
+
+func FuzzShadowed(f *testing.F) {
+	f.Fuzz(func(f *testing.T, data []byte) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by f\.TempDir\(\) in anonymous function`
+	})
+}
+
+func FuzzNoName(f *testing.F) {
+	f.Fuzz(func(_ *testing.T, data []byte) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by <t/b>\.TempDir\(\) in anonymous function`
+	})
+}
+
+func FuzzAssign(f *testing.F) {
+	dir, err := os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by f\.TempDir\(\) in FuzzAssign`
+	if err != nil {
+		f.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		sub, err := os.MkdirTemp(dir, "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in anonymous function \(no suggested fix: the directory is not created inside the system temporary directory\)`
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_ = sub
+	})
+}
@@ -0,0 +1,52 @@
+package generic
+
+import (
+	"os"
+	"testing"
+)
+
+func withEnv[T testing.TB](tb T, name string) {
+	os.MkdirTemp("", name) // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in withEnv`
+}
+
+func withUnion[T *testing.T | *testing.B](t T) {
+	os.MkdirTemp("", "")
+}
+
+func withInlineConstraint[T interface{ testing.TB }](tb T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in withInlineConstraint`
+}
+
+type customTB interface {
+	testing.TB
+
+	Foo()
+}
+
+func withInterface(h customTB) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by h\.TempDir\(\) in withInterface`
+}
+
+func withConstraint[T customTB](h T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by h\.TempDir\(\) in withConstraint`
+}
+
+type harness[T testing.TB] struct {
+	tb T
+}
+
+func (h *harness[T]) setup() {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by h\.tb\.TempDir\(\) in setup`
+}
+
+type logger interface {
+	Log(args ...any)
+}
+
+func withPartialInterface(l logger) {
+	os.MkdirTemp("", "")
+}
+
+func withAny[T any](v T) {
+	os.MkdirTemp("", "")
+}
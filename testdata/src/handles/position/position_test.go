@@ -0,0 +1,49 @@
+package position
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+func writeFixture(name string, t *testing.T) {
+	os.MkdirTemp("", name) // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in writeFixture`
+}
+
+func setup(ctx context.Context, tb testing.TB) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in setup`
+}
+
+func grouped(a, t *testing.T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by a\.TempDir\(\) in grouped`
+}
+
+func groupedBlank(_, t *testing.T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in groupedBlank`
+}
+
+func preferNamed(_ *testing.T, tb testing.TB) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in preferNamed`
+}
+
+func noName(s string, _ *testing.B) {
+	os.MkdirTemp("", s) // want `os\.MkdirTemp\(\) could be replaced by <t/b>\.TempDir\(\) in noName`
+}
+
+func noHandle(s string, n int) {
+	os.MkdirTemp("", s)
+}
+
+func Test_FuncLit(t *testing.T) {
+	fn := func(name string, tb testing.TB) {
+		os.MkdirTemp("", name) // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in anonymous function`
+	}
+
+	fn("", t)
+}
+
+func anonymous() {
+	_ = func(name string, tb testing.TB) {
+		os.MkdirTemp("", name) // want `os\.MkdirTemp\(\) could be replaced by tb\.TempDir\(\) in anonymous function`
+	}
+}
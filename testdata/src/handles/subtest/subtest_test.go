@@ -0,0 +1,58 @@
+package subtest
+
+import (
+	"os"
+	"testing"
+)
+
+func Test_Run(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_Run/foo`
+
+		t.Run("bar", func(t *testing.T) {
+			os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_Run/foo/bar`
+		})
+	})
+}
+
+func Test_Run_other_name(t *testing.T) {
+	t.Run("foo", func(st *testing.T) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by st\.TempDir\(\) in Test_Run_other_name/foo`
+	})
+}
+
+func Test_Run_no_name(t *testing.T) {
+	t.Run("foo", func(_ *testing.T) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by <t/b>\.TempDir\(\) in Test_Run_no_name/foo`
+	})
+}
+
+func Test_Run_dynamic_name(t *testing.T) {
+	for _, name := range []string{"a", "b"} {
+		t.Run(name, func(t *testing.T) {
+			os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in anonymous function`
+		})
+	}
+}
+
+func Test_Run_outer_call(t *testing.T) {
+	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_Run_outer_call`
+
+	t.Run("foo", func(sub *testing.T) {
+		defer func() {
+			os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by sub\.TempDir\(\) in Test_Run_outer_call/foo`
+		}()
+	})
+}
+
+func Benchmark_Run(b *testing.B) {
+	b.Run("foo", func(b *testing.B) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by b\.TempDir\(\) in Benchmark_Run/foo`
+	})
+}
+
+func helper(t *testing.T) func(*testing.T) {
+	return func(sub *testing.T) {
+		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by sub\.TempDir\(\) in anonymous function`
+	}
+}
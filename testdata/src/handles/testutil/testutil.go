@@ -0,0 +1,12 @@
+package testutil
+
+import "testing"
+
+type T = testing.T
+
+type B = testing.B
+
+type TB = testing.TB
+
+// NotT is not an alias.
+type NotT testing.T
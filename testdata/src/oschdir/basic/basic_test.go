@@ -18,14 +18,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		os.Chdir("")
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
 	return func() {
-		os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		os.Chdir("")
 	}
 }
 
@@ -95,7 +95,7 @@ func Test_CallExpr_deep(t *testing.T) {
 
 func Test_GoStmt(t *testing.T) {
 	go func() {
-		os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		os.Chdir("")
 	}()
 }
 
@@ -157,7 +157,7 @@ func Test_SelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+				os.Chdir("")
 			}
 		}
 	}()
@@ -177,7 +177,7 @@ func Test_SelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+					os.Chdir("")
 				}()
 			}
 		}
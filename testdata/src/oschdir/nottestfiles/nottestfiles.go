@@ -18,14 +18,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		os.Chdir("")
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
 	return func() {
-		os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		os.Chdir("")
 	}
 }
 
@@ -99,7 +99,7 @@ func FunctionCallExpr_deep(t *testing.T) {
 
 func FunctionGoStmt(t *testing.T) {
 	go func() {
-		os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		os.Chdir("")
 	}()
 }
 
@@ -161,7 +161,7 @@ func FunctionSelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+				os.Chdir("")
 			}
 		}
 	}()
@@ -181,7 +181,7 @@ func FunctionSelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+					os.Chdir("")
 				}()
 			}
 		}
@@ -0,0 +1,30 @@
+package parallel
+
+import (
+	"os"
+	"testing"
+)
+
+func Test_Parallel(t *testing.T) {
+	t.Parallel()
+
+	os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in Test_Parallel \(no suggested fix: t\.Chdir\(\) panics in parallel tests\)`
+}
+
+func Test_Parallel_parent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("foo", func(t *testing.T) {
+		os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in Test_Parallel_parent/foo \(no suggested fix: t\.Chdir\(\) panics in parallel tests\)`
+	})
+}
+
+func Test_Parallel_subtest(t *testing.T) {
+	os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+
+	t.Run("foo", func(t *testing.T) {
+		t.Parallel()
+
+		os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in Test_Parallel_subtest/foo \(no suggested fix: t\.Chdir\(\) panics in parallel tests\)`
+	})
+}
@@ -0,0 +1,59 @@
+package restore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_defer(t *testing.T) {
+	wd, _ := os.Getwd()
+	os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+	defer os.Chdir(wd)
+}
+
+func Test_defer_error(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir("foo"); err != nil { // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+}
+
+func Test_cleanup(t *testing.T) {
+	wd, _ := os.Getwd()
+
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Error(err)
+		}
+	})
+
+	os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+}
+
+func Test_wd_used(t *testing.T) {
+	wd, _ := os.Getwd()
+	os.Chdir(filepath.Join(wd, "foo")) // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+	defer os.Chdir(wd)
+}
+
+func Test_restore_other_dir(t *testing.T) {
+	wd, _ := os.Getwd()
+	os.Chdir("foo")    // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+	defer os.Chdir("") // want `os\.Chdir\(\) could be replaced by t\.Chdir\(\) in .+`
+	_ = wd
+}
+
+func Test_NoName(_ *testing.T) {
+	wd, _ := os.Getwd()
+	os.Chdir("foo") // want `os\.Chdir\(\) could be replaced by <t/b>\.Chdir\(\) in .+`
+	defer os.Chdir(wd)
+}
@@ -0,0 +1,32 @@
+package ioutil
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func Test_ExprStmt(t *testing.T) {
+	ioutil.TempFile("", "") // want `ioutil\.TempFile\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func Test_AssignStmt(t *testing.T) {
+	f, err := ioutil.TempFile("", "foo") // want `ioutil\.TempFile\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = f
+}
+
+func Test_Dir(t *testing.T) {
+	ioutil.TempFile("foo", "")
+}
+
+func Test_OS(t *testing.T) {
+	os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func foobar() {
+	ioutil.TempFile("", "")
+}
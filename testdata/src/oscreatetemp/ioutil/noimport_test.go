@@ -0,0 +1,10 @@
+package ioutil
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func Test_NoImport(t *testing.T) {
+	ioutil.TempFile("", "") // want `ioutil\.TempFile\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
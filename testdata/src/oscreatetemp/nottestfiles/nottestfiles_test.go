@@ -15,14 +15,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+		os.CreateTemp("", "")
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
 	return func() {
-		os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+		os.CreateTemp("", "")
 	}
 }
 
@@ -39,9 +39,9 @@ func FunctionBench_ExprStmt(b *testing.B) {
 }
 
 func FunctionExprStmt(t *testing.T) {
-	os.CreateTemp("", "")   // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
-	os.CreateTemp("", "xx") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
-	os.CreateTemp(os.TempDir(), "xx")
+	os.CreateTemp("", "")             // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+	os.CreateTemp("", "xx")           // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+	os.CreateTemp(os.TempDir(), "xx") // want `os\.CreateTemp\(os\.TempDir\(\), \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
 	os.CreateTemp(t.TempDir(), "xx")
 }
 
@@ -83,7 +83,7 @@ func FunctionCallExpr(t *testing.T) {
 
 func FunctionGoStmt(t *testing.T) {
 	go func() {
-		os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+		os.CreateTemp("", "")
 	}()
 }
 
@@ -125,7 +125,7 @@ func FunctionSelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+				os.CreateTemp("", "")
 			}
 		}
 	}()
@@ -145,7 +145,7 @@ func FunctionSelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.CreateTemp("", "") // want `os\.CreateTemp\("", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+					os.CreateTemp("", "")
 				}()
 			}
 		}
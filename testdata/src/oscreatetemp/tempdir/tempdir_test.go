@@ -0,0 +1,46 @@
+package tempdir
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+)
+
+const empty = ""
+
+const notEmpty = "foo"
+
+func Test_Const(t *testing.T) {
+	os.CreateTemp(empty, "") // want `os\.CreateTemp\(empty, \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func Test_Const_expr(t *testing.T) {
+	os.CreateTemp(empty+"", "") // want `os\.CreateTemp\(empty \+ "", \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func Test_Const_not_empty(t *testing.T) {
+	os.CreateTemp(notEmpty, "")
+}
+
+func Test_TempDir(t *testing.T) {
+	os.CreateTemp(os.TempDir(), "") // want `os\.CreateTemp\(os\.TempDir\(\), \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func Test_Join(t *testing.T) {
+	os.CreateTemp(filepath.Join(os.TempDir(), "foo"), "") // want `os\.CreateTemp\(filepath\.Join\(os\.TempDir\(\), "foo"\), \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func Test_Join_nested(t *testing.T) {
+	os.CreateTemp(path.Join(filepath.Join(os.TempDir(), "foo"), "bar"), "") // want `os\.CreateTemp\(path\.Join\(.+\), \.\.\.\) could be replaced by os\.CreateTemp\(t\.TempDir\(\), \.\.\.\) in .+`
+}
+
+func Test_Join_other(t *testing.T) {
+	os.CreateTemp(filepath.Join("foo", os.TempDir()), "")
+}
+
+func Test_Variable(t *testing.T) {
+	dir := ""
+
+	os.CreateTemp(dir, "")
+}
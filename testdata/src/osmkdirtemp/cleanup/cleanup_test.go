@@ -0,0 +1,94 @@
+package cleanup
+
+import (
+	"os"
+	"testing"
+)
+
+func Test_defer(t *testing.T) {
+	dir, err := os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_ = dir
+}
+
+func Test_cleanup(t *testing.T) {
+	dir, err := os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Log(err)
+		}
+	})
+
+	_ = dir
+}
+
+func Test_defer_func(t *testing.T) {
+	dir, _ := os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	_ = dir
+}
+
+func Test_no_cleanup(t *testing.T) {
+	dir, err := os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = dir
+}
+
+func Test_redeclared(t *testing.T) {
+	var dir string
+
+	dir, err := os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = dir
+}
+
+func Test_error_used(t *testing.T) {
+	dir, err := os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+ \(no suggested fix: the error is used outside of the error check\)`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = os.Stat(dir)
+	_ = err
+}
+
+func Test_existing_variables(t *testing.T) {
+	var dir string
+	var err error
+
+	dir, err = os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+ \(no suggested fix: the results are assigned to existing variables\)`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = dir
+}
+
+func Test_if_scoped(t *testing.T) {
+	if dir, err := os.MkdirTemp("", "foo"); err == nil { // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+ \(no suggested fix: the directory is only available inside the if statement\)`
+		_ = dir
+	}
+}
+
+func Test_expression(t *testing.T) {
+	t.Log(os.MkdirTemp("", "foo")) // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+ \(no suggested fix: the results are not assigned by a short variable declaration\)`
+}
+
+func Test_NoName(_ *testing.T) {
+	_, _ = os.MkdirTemp("", "foo") // want `os\.MkdirTemp\(\) could be replaced by <t/b>\.TempDir\(\) in Test_NoName$`
+}
@@ -0,0 +1,10 @@
+package ioutil
+
+import (
+	. "io/ioutil"
+	"testing"
+)
+
+func Test_Dot(t *testing.T) {
+	TempDir("", "") // want `ioutil\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+}
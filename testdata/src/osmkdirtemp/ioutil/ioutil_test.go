@@ -0,0 +1,35 @@
+package ioutil
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func Test_ExprStmt(t *testing.T) {
+	ioutil.TempDir("", "") // want `ioutil\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+}
+
+func Test_AssignStmt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "foo") // want `ioutil\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_ = dir
+}
+
+func Test_IfStmt(t *testing.T) {
+	if _, err := ioutil.TempDir("", ""); err != nil { // want `ioutil\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		t.Fatal(err)
+	}
+}
+
+func Test_CallExpr(t *testing.T) {
+	t.Log(ioutil.TempDir("", "")) // want `ioutil\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+ \(no suggested fix: the results are not assigned by a short variable declaration\)`
+}
+
+func foobar() {
+	ioutil.TempDir("", "")
+}
@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.MkdirTemp("", "")
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
 	return func() {
-		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.MkdirTemp("", "")
 	}
 }
 
@@ -99,7 +99,7 @@ func FunctionCallExpr_deep(t *testing.T) {
 
 func FunctionGoStmt(t *testing.T) {
 	go func() {
-		os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.MkdirTemp("", "")
 	}()
 }
 
@@ -149,7 +149,7 @@ func FunctionSelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+				os.MkdirTemp("", "")
 			}
 		}
 	}()
@@ -169,7 +169,7 @@ func FunctionSelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.MkdirTemp("", "") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in .+`
+					os.MkdirTemp("", "")
 				}()
 			}
 		}
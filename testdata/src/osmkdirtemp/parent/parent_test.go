@@ -0,0 +1,54 @@
+package parent
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Parent(t *testing.T) {
+	parent := filepath.Join("testdata", "out")
+
+	dir, err := os.MkdirTemp(parent, "x") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_Parent \(no suggested fix: the directory is not created inside the system temporary directory\)`
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_ = dir
+}
+
+func Test_ParentLiteral(t *testing.T) {
+	os.MkdirTemp("testdata", "x") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_ParentLiteral \(no suggested fix: the directory is not created inside the system temporary directory\)`
+}
+
+func Test_ParentIoutil(t *testing.T) {
+	dir, err := ioutil.TempDir("testdata", "x") // want `ioutil\.TempDir\(\) could be replaced by t\.TempDir\(\) in Test_ParentIoutil \(no suggested fix: the directory is not created inside the system temporary directory\)`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = dir
+}
+
+func Test_SystemTempDir(t *testing.T) {
+	dir, err := os.MkdirTemp(os.TempDir(), "x") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_SystemTempDir`
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_ = dir
+}
+
+func Test_Empty(t *testing.T) {
+	const empty = ""
+
+	dir, err := os.MkdirTemp(empty, "x") // want `os\.MkdirTemp\(\) could be replaced by t\.TempDir\(\) in Test_Empty`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = dir
+}
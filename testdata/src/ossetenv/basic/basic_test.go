@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		os.Setenv("foo", "bar")
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
 	return func() {
-		os.Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		os.Setenv("foo", "bar")
 	}
 }
 
@@ -94,7 +94,7 @@ func Test_CallExpr_deep(t *testing.T) {
 
 func Test_GoStmt(t *testing.T) {
 	go func() {
-		os.Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		os.Setenv("", "")
 	}()
 }
 
@@ -156,7 +156,7 @@ func Test_SelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+				os.Setenv("", "")
 			}
 		}
 	}()
@@ -176,7 +176,7 @@ func Test_SelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+					os.Setenv("", "")
 				}()
 			}
 		}
@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		Setenv("foo", "bar")
 	}
 }
 
 func bir(t *testing.T) func() {
 	Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
 	return func() {
-		Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		Setenv("foo", "bar")
 	}
 }
 
@@ -94,7 +94,7 @@ func Test_CallExpr_deep(t *testing.T) {
 
 func Test_GoStmt(t *testing.T) {
 	go func() {
-		Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		Setenv("", "")
 	}()
 }
 
@@ -156,7 +156,7 @@ func Test_SelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+				Setenv("", "")
 			}
 		}
 	}()
@@ -176,7 +176,7 @@ func Test_SelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+					Setenv("", "")
 				}()
 			}
 		}
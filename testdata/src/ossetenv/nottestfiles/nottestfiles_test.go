@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		os.Setenv("foo", "bar")
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
 	return func() {
-		os.Setenv("foo", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		os.Setenv("foo", "bar")
 	}
 }
 
@@ -98,7 +98,7 @@ func FunctionCallExpr_deep(t *testing.T) {
 
 func FunctionGoStmt(t *testing.T) {
 	go func() {
-		os.Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		os.Setenv("", "")
 	}()
 }
 
@@ -160,7 +160,7 @@ func FunctionSelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+				os.Setenv("", "")
 			}
 		}
 	}()
@@ -180,7 +180,7 @@ func FunctionSelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.Setenv("", "") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+					os.Setenv("", "")
 				}()
 			}
 		}
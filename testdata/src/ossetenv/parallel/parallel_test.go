@@ -0,0 +1,30 @@
+package parallel
+
+import (
+	"os"
+	"testing"
+)
+
+func Test_Parallel(t *testing.T) {
+	t.Parallel()
+
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in Test_Parallel \(no suggested fix: t\.Setenv\(\) panics in parallel tests\)`
+}
+
+func Test_Parallel_parent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("foo", func(t *testing.T) {
+		os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in Test_Parallel_parent/foo \(no suggested fix: t\.Setenv\(\) panics in parallel tests\)`
+	})
+}
+
+func Test_Parallel_subtest(t *testing.T) {
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+
+	t.Run("foo", func(t *testing.T) {
+		t.Parallel()
+
+		os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in Test_Parallel_subtest/foo \(no suggested fix: t\.Setenv\(\) panics in parallel tests\)`
+	})
+}
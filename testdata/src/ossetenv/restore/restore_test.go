@@ -0,0 +1,126 @@
+package restore
+
+import (
+	"os"
+	"testing"
+)
+
+func Test_Getenv_defer(t *testing.T) {
+	old := os.Getenv("FOO")
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Setenv("FOO", old)
+}
+
+func Test_Getenv_defer_before(t *testing.T) {
+	old := os.Getenv("FOO")
+	defer os.Setenv("FOO", old)
+
+	if err := os.Setenv("FOO", "bar"); err != nil { // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		t.Fatal(err)
+	}
+}
+
+func Test_Getenv_defer_func(t *testing.T) {
+	old := os.Getenv("FOO")
+
+	err := os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = os.Setenv("FOO", old)
+	}()
+}
+
+func Test_Getenv_used(t *testing.T) {
+	old := os.Getenv("FOO")
+	os.Setenv("FOO", old+":bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Setenv("FOO", old)
+}
+
+func Test_LookupEnv_cleanup(t *testing.T) {
+	old, ok := os.LookupEnv("FOO")
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv("FOO", old)
+		} else {
+			os.Unsetenv("FOO")
+		}
+	})
+}
+
+func Test_Unsetenv_cleanup(t *testing.T) {
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	t.Cleanup(func() { os.Unsetenv("FOO") })
+}
+
+func Test_Unsetenv_defer(t *testing.T) {
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Unsetenv("FOO")
+}
+
+func Test_other_key(t *testing.T) {
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Unsetenv("BAR")
+}
+
+func Test_restore_not_saved(t *testing.T) {
+	os.Setenv("FOO", "bar")     // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Setenv("FOO", "a") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_err_reused(t *testing.T) {
+	err := os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.Unsetenv("BAR")
+	_ = err
+}
+
+func Test_if_else(t *testing.T) {
+	if err := os.Setenv("FOO", "bar"); err != nil { // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		t.Fatal(err)
+	} else {
+		t.Log("ok")
+	}
+}
+
+func Test_Key_variable(t *testing.T) {
+	key := "FOO"
+	old := os.Getenv(key)
+	os.Setenv(key, "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Setenv(key, old)
+}
+
+func Test_Key_const(t *testing.T) {
+	const key = "FOO"
+
+	old := os.Getenv("FOO")
+	os.Setenv(key, "bar") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Setenv("FOO", old)
+}
+
+func Test_Key_reassigned(t *testing.T) {
+	key := "A"
+	old := os.Getenv(key)
+	os.Setenv(key, "1") // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	key = "B"
+	defer os.Setenv(key, old) // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_Key_expression(t *testing.T) {
+	keys := []string{"A"}
+	old := os.Getenv(keys[0])
+	os.Setenv(keys[0], "1")       // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	defer os.Setenv(keys[0], old) // want `os\.Setenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_NoName(_ *testing.T) {
+	old := os.Getenv("FOO")
+	os.Setenv("FOO", "bar") // want `os\.Setenv\(\) could be replaced by <t/b>\.Setenv\(\) in .+`
+	defer os.Setenv("FOO", old)
+}
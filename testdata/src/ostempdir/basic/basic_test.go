@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.TempDir()
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
 	return func() {
-		os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.TempDir()
 	}
 }
 
@@ -94,7 +94,7 @@ func Test_CallExpr_deep(t *testing.T) {
 
 func Test_GoStmt(t *testing.T) {
 	go func() {
-		os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.TempDir()
 	}()
 }
 
@@ -156,7 +156,7 @@ func Test_SelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+				os.TempDir()
 			}
 		}
 	}()
@@ -176,7 +176,7 @@ func Test_SelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+					os.TempDir()
 				}()
 			}
 		}
@@ -0,0 +1,60 @@
+package mkdirall
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_MkdirAll(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), t.Name()) // want `temporary directory created with os\.TempDir\(\)\+os\.MkdirAll\(\) could be replaced by t\.TempDir\(\) in Test_MkdirAll`
+	os.MkdirAll(dir, 0o755)
+	defer os.RemoveAll(dir)
+
+	_ = dir
+}
+
+func Test_MkdirAll_error_check(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "foo", "bar") // want `temporary directory created with os\.TempDir\(\)\+os\.MkdirAll\(\) could be replaced by t\.TempDir\(\) in Test_MkdirAll_error_check`
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	_ = dir
+}
+
+func Test_Mkdir(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "foo") // want `temporary directory created with os\.TempDir\(\)\+os\.Mkdir\(\) could be replaced by t\.TempDir\(\) in Test_Mkdir`
+	err := os.Mkdir(dir, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = dir
+}
+
+func Test_Assign(t *testing.T) {
+	var dir string
+
+	dir = filepath.Join(os.TempDir(), "foo") // want `temporary directory created with os\.TempDir\(\)\+os\.MkdirAll\(\) could be replaced by t\.TempDir\(\) in Test_Assign`
+	_ = os.MkdirAll(dir, 0o755)
+
+	_ = dir
+}
+
+func Test_No_MkdirAll(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "foo") // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+
+	_ = dir
+}
+
+func Test_Other_dir(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "foo") // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+	os.MkdirAll("bar", 0o755)
+
+	_ = dir
+}
@@ -17,14 +17,14 @@ func bar() func(t *testing.T) {
 
 func bur(t *testing.T) func() {
 	return func() {
-		os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.TempDir()
 	}
 }
 
 func bir(t *testing.T) func() {
 	os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
 	return func() {
-		os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.TempDir()
 	}
 }
 
@@ -98,7 +98,7 @@ func FunctionCallExpr_deep(t *testing.T) {
 
 func FunctionGoStmt(t *testing.T) {
 	go func() {
-		os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+		os.TempDir()
 	}()
 }
 
@@ -160,7 +160,7 @@ func FunctionSelectStmt(t *testing.T) {
 		for {
 			select {
 			case <-doneCh:
-				os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+				os.TempDir()
 			}
 		}
 	}()
@@ -180,7 +180,7 @@ func FunctionSelectStmt_anon_func(t *testing.T) {
 			select {
 			case <-doneCh:
 				func() {
-					os.TempDir() // want `os\.TempDir\(\) could be replaced by t\.TempDir\(\) in .+`
+					os.TempDir()
 				}()
 			}
 		}
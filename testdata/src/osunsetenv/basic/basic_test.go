@@ -0,0 +1,90 @@
+package basic
+
+import (
+	"os"
+	"testing"
+)
+
+func bar() func(t *testing.T) {
+	return func(t *testing.T) {
+		os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	}
+}
+
+func Test_NoName(_ *testing.T) {
+	os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by <t/b>\.Setenv\(\) in .+`
+}
+
+func Benchmark_ExprStmt(b *testing.B) {
+	os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by b\.Setenv\(\) in .+`
+}
+
+func Test_ExprStmt(t *testing.T) {
+	os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_ExprStmt_key(t *testing.T) {
+	key := "FOO"
+
+	os.Unsetenv(key) // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_AssignStmt_ignore_return(t *testing.T) {
+	_ = os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_IfStmt(t *testing.T) {
+	if err := os.Unsetenv("FOO"); err != nil { // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+		t.Fatal(err)
+	}
+}
+
+func Test_AssignStmt_error_check(t *testing.T) {
+	err := os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_BlockStmt(t *testing.T) {
+	for range 2 {
+		os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+	}
+}
+
+func Test_CallExpr(t *testing.T) {
+	t.Log(os.Unsetenv("FOO")) // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_Clearenv(t *testing.T) {
+	os.Clearenv() // want `os\.Clearenv\(\) could be replaced by t\.Setenv\(\) in .+ \(no suggested fix: the variables to restore are unknown\)`
+}
+
+func Test_Restore(t *testing.T) {
+	os.Setenv("FOO", "bar")
+	defer os.Unsetenv("FOO")
+
+	t.Cleanup(func() {
+		os.Unsetenv("FOO")
+	})
+}
+
+func Test_Leak(t *testing.T) {
+	defer os.Unsetenv("BAR") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in Test_Leak \(no suggested fix: the call is deferred\)`
+
+	t.Cleanup(func() {
+		os.Unsetenv("BAR") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in Test_Leak \(no suggested fix: the call is deferred\)`
+	})
+
+	defer os.Clearenv() // want `os\.Clearenv\(\) could be replaced by t\.Setenv\(\) in Test_Leak \(no suggested fix: the variables to restore are unknown\)`
+}
+
+func Test_Parallel(t *testing.T) {
+	t.Parallel()
+
+	os.Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in Test_Parallel \(no suggested fix: t\.Setenv\(\) panics in parallel tests\)`
+}
+
+func foobar() {
+	os.Unsetenv("FOO")
+}
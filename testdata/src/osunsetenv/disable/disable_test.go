@@ -0,0 +1,90 @@
+package disable
+
+import (
+	"os"
+	"testing"
+)
+
+func bar() func(t *testing.T) {
+	return func(t *testing.T) {
+		os.Unsetenv("FOO")
+	}
+}
+
+func Test_NoName(_ *testing.T) {
+	os.Unsetenv("FOO")
+}
+
+func Benchmark_ExprStmt(b *testing.B) {
+	os.Unsetenv("FOO")
+}
+
+func Test_ExprStmt(t *testing.T) {
+	os.Unsetenv("FOO")
+}
+
+func Test_ExprStmt_key(t *testing.T) {
+	key := "FOO"
+
+	os.Unsetenv(key)
+}
+
+func Test_AssignStmt_ignore_return(t *testing.T) {
+	_ = os.Unsetenv("FOO")
+}
+
+func Test_IfStmt(t *testing.T) {
+	if err := os.Unsetenv("FOO"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_AssignStmt_error_check(t *testing.T) {
+	err := os.Unsetenv("FOO")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_BlockStmt(t *testing.T) {
+	for range 2 {
+		os.Unsetenv("FOO")
+	}
+}
+
+func Test_CallExpr(t *testing.T) {
+	t.Log(os.Unsetenv("FOO"))
+}
+
+func Test_Clearenv(t *testing.T) {
+	os.Clearenv()
+}
+
+func Test_Restore(t *testing.T) {
+	os.Setenv("FOO", "bar")
+	defer os.Unsetenv("FOO")
+
+	t.Cleanup(func() {
+		os.Unsetenv("FOO")
+	})
+}
+
+func Test_Leak(t *testing.T) {
+	defer os.Unsetenv("BAR")
+
+	t.Cleanup(func() {
+		os.Unsetenv("BAR")
+	})
+
+	defer os.Clearenv()
+}
+
+func Test_Parallel(t *testing.T) {
+	t.Parallel()
+
+	os.Unsetenv("FOO")
+}
+
+func foobar() {
+	os.Unsetenv("FOO")
+}
@@ -0,0 +1,14 @@
+package dot
+
+import (
+	. "os"
+	"testing"
+)
+
+func Test_ExprStmt(t *testing.T) {
+	Unsetenv("FOO") // want `os\.Unsetenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
+
+func Test_Clearenv(t *testing.T) {
+	Clearenv() // want `os\.Clearenv\(\) could be replaced by t\.Setenv\(\) in .+`
+}
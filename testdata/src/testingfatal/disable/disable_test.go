@@ -0,0 +1,135 @@
+package disable
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_OsExit(t *testing.T) {
+	os.Exit(1)
+}
+
+func Test_LogFatal(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func Test_LogFatalf(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Fatalf("setup: %v", err)
+	}
+}
+
+func Test_LogFatalln(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func Test_LogPanic(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func Test_LogPanicf(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Panicf("setup: %v", err)
+	}
+}
+
+func Test_Panic(t *testing.T) {
+	err := setup()
+	if err != nil {
+		panic(err)
+	}
+}
+
+func Test_PanicNotError(t *testing.T) {
+	panic("foo")
+}
+
+func Test_Goroutine(t *testing.T) {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		if err := setup(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	wg.Wait()
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		if err := setup(); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func Test_NoName(_ *testing.T) {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func Benchmark_LogFatal(b *testing.B) {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func Test_Logger(t *testing.T) {
+	logger := log.New(os.Stderr, "", 0)
+
+	if err := setup(); err != nil {
+		logger.Fatal(err)
+	}
+}
+
+func Test_Escape(t *testing.T) {
+	go func() {
+		os.Exit(1)
+	}()
+}
+
+func returned(t *testing.T) func() {
+	return func() {
+		if err := setup(); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func setup() error {
+	return errors.New("foo")
+}
+
+func bar() {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+}
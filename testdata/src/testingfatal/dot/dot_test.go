@@ -0,0 +1,19 @@
+package dot
+
+import (
+	"errors"
+	. "log"
+	. "os"
+	"testing"
+)
+
+func Test_OsExit(t *testing.T) {
+	Exit(1) // want `os\.Exit\(\) could be replaced by t\.Fatal\(\) in Test_OsExit`
+}
+
+func Test_LogFatalf(t *testing.T) {
+	err := errors.New("foo")
+	if err != nil {
+		Fatalf("setup: %v", err) // want `log\.Fatalf\(\) could be replaced by t\.Fatalf\(\) in Test_LogFatalf`
+	}
+}
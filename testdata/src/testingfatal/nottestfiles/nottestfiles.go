@@ -0,0 +1,135 @@
+package nottestfiles
+
+import (
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func Test_OsExit(t *testing.T) {
+	os.Exit(1) // want `os\.Exit\(\) could be replaced by t\.Fatal\(\) in Test_OsExit`
+}
+
+func Test_LogFatal(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Fatal(err) // want `log\.Fatal\(\) could be replaced by t\.Fatal\(\) in Test_LogFatal`
+	}
+}
+
+func Test_LogFatalf(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Fatalf("setup: %v", err) // want `log\.Fatalf\(\) could be replaced by t\.Fatalf\(\) in Test_LogFatalf`
+	}
+}
+
+func Test_LogFatalln(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Fatalln(err) // want `log\.Fatalln\(\) could be replaced by t\.Fatal\(\) in Test_LogFatalln`
+	}
+}
+
+func Test_LogPanic(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Panic(err) // want `log\.Panic\(\) could be replaced by t\.Fatal\(\) in Test_LogPanic`
+	}
+}
+
+func Test_LogPanicf(t *testing.T) {
+	err := setup()
+	if err != nil {
+		log.Panicf("setup: %v", err) // want `log\.Panicf\(\) could be replaced by t\.Fatalf\(\) in Test_LogPanicf`
+	}
+}
+
+func Test_Panic(t *testing.T) {
+	err := setup()
+	if err != nil {
+		panic(err) // want `panic\(\) could be replaced by t\.Fatal\(\) in Test_Panic`
+	}
+}
+
+func Test_PanicNotError(t *testing.T) {
+	panic("foo")
+}
+
+func Test_Goroutine(t *testing.T) {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		if err := setup(); err != nil {
+			log.Fatal(err) // want `log\.Fatal\(\) could be replaced by t\.Fatal\(\) in Test_Goroutine \(no suggested fix: the call is inside a goroutine\)`
+		}
+	}()
+
+	wg.Wait()
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		if err := setup(); err != nil {
+			panic(err) // want `panic\(\) could be replaced by t\.Fatal\(\) in Test_SubTest/foo`
+		}
+	})
+}
+
+func Test_NoName(_ *testing.T) {
+	if err := setup(); err != nil {
+		log.Fatal(err) // want `log\.Fatal\(\) could be replaced by <t/b>\.Fatal\(\) in Test_NoName`
+	}
+}
+
+func Benchmark_LogFatal(b *testing.B) {
+	if err := setup(); err != nil {
+		log.Fatal(err) // want `log\.Fatal\(\) could be replaced by b\.Fatal\(\) in Benchmark_LogFatal`
+	}
+}
+
+func Test_Logger(t *testing.T) {
+	logger := log.New(os.Stderr, "", 0)
+
+	if err := setup(); err != nil {
+		logger.Fatal(err)
+	}
+}
+
+func Test_Escape(t *testing.T) {
+	go func() {
+		os.Exit(1) // want `os\.Exit\(\) could be replaced by t\.Fatal\(\) in Test_Escape \(no suggested fix: the call is inside a goroutine\)`
+	}()
+}
+
+func returned(t *testing.T) func() {
+	return func() {
+		if err := setup(); err != nil {
+			log.Fatal(err) // want `log\.Fatal\(\) could be replaced by t\.Fatal\(\) in returned \(no suggested fix: the function can run after the end of the test\)`
+		}
+	}
+}
+
+func setup() error {
+	return errors.New("foo")
+}
+
+func bar() {
+	if err := setup(); err != nil {
+		log.Fatal(err)
+	}
+}
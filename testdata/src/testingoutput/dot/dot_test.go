@@ -0,0 +1,17 @@
+package dot
+
+import (
+	"log"
+	. "os"
+	"testing"
+)
+
+func Test_LogNew(t *testing.T) {
+	logger := log.New(Stderr, "", 0) // want `os\.Stderr could be replaced by t\.Output\(\) in Test_LogNew`
+	logger.Println("foo")
+}
+
+func Test_SetOutput(t *testing.T) {
+	log.SetOutput(Stdout) // want `os\.Stdout could be replaced by t\.Output\(\) in Test_SetOutput \(no suggested fix: the standard logger outlives the test\)`
+	log.Println("foo")
+}
@@ -0,0 +1,82 @@
+package nottestfiles
+
+import (
+	"bytes"
+	"log"
+	"log/slog"
+	"os"
+	"testing"
+)
+
+func Test_LogNew(t *testing.T) {
+	logger := log.New(os.Stderr, "", 0) // want `os\.Stderr could be replaced by t\.Output\(\) in Test_LogNew`
+	logger.Println("foo")
+}
+
+func Test_SlogTextHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil)) // want `os\.Stdout could be replaced by t\.Output\(\) in Test_SlogTextHandler`
+	logger.Info("foo")
+}
+
+func Test_SlogJSONHandler(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil)) // want `os\.Stderr could be replaced by t\.Output\(\) in Test_SlogJSONHandler`
+	logger.Info("foo")
+}
+
+func Test_LoggerSetOutput(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(&buf, "", 0)
+	logger.SetOutput(os.Stdout) // want `os\.Stdout could be replaced by t\.Output\(\) in Test_LoggerSetOutput`
+	logger.Println("foo")
+}
+
+func Test_SetOutput(t *testing.T) {
+	log.SetOutput(os.Stdout) // want `os\.Stdout could be replaced by t\.Output\(\) in Test_SetOutput \(no suggested fix: the standard logger outlives the test\)`
+	log.Println("foo")
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		logger := log.New(os.Stderr, "", 0) // want `os\.Stderr could be replaced by t\.Output\(\) in Test_SubTest/foo`
+		logger.Println("foo")
+	})
+}
+
+func Test_NoName(_ *testing.T) {
+	logger := log.New(os.Stderr, "", 0) // want `os\.Stderr could be replaced by <t/b>\.Output\(\) in Test_NoName`
+	logger.Println("foo")
+}
+
+func Benchmark_LogNew(b *testing.B) {
+	logger := log.New(os.Stderr, "", 0) // want `os\.Stderr could be replaced by b\.Output\(\) in Benchmark_LogNew`
+	logger.Println("foo")
+}
+
+func Test_Output(t *testing.T) {
+	logger := log.New(t.Output(), "", 0)
+	logger.Println("foo")
+}
+
+func Test_Other(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := log.New(&buf, "", 0)
+	logger.Println("foo")
+
+	f, _ := os.Create(os.DevNull)
+	logger = log.New(f, "", 0)
+	logger.Println("foo")
+}
+
+func Test_Escape(t *testing.T) {
+	go func() {
+		logger := log.New(os.Stderr, "", 0)
+		logger.Println("foo")
+	}()
+}
+
+func bar() {
+	logger := log.New(os.Stderr, "", 0)
+	logger.Println("foo")
+}
@@ -0,0 +1,52 @@
+package disable
+
+import (
+	"testing"
+)
+
+func Test_Setenv(t *testing.T) {
+	t.Parallel()
+
+	t.Setenv("FOO", "bar")
+}
+
+func Test_Chdir(t *testing.T) {
+	t.Chdir("foo")
+
+	t.Parallel()
+}
+
+func Test_Subtest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("foo", func(t *testing.T) {
+		t.Setenv("FOO", "bar")
+
+		t.Run("bar", func(t *testing.T) {
+			t.Chdir("foo")
+		})
+	})
+}
+
+func Test_Subtest_parallel(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	t.Run("foo", func(t *testing.T) {
+		t.Parallel()
+
+		t.Setenv("FOO", "bar")
+	})
+
+	t.Run("bar", func(t *testing.T) {
+		t.Setenv("FOO", "bar")
+	})
+}
+
+func Test_Sequential(t *testing.T) {
+	t.Setenv("FOO", "bar")
+	t.Chdir("foo")
+}
+
+func Benchmark_Setenv(b *testing.B) {
+	b.Setenv("FOO", "bar")
+}
@@ -0,0 +1,15 @@
+package dot
+
+import (
+	. "testing"
+)
+
+func Test_Setenv(t *T) {
+	t.Parallel()
+
+	t.Setenv("FOO", "bar") // want `t\.Setenv\(\) cannot be used in Test_Setenv: the test or one of its parents is parallel`
+}
+
+func Test_Sequential(t *T) {
+	t.Setenv("FOO", "bar")
+}
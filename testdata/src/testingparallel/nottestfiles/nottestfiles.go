@@ -0,0 +1,52 @@
+package nottestfiles
+
+import (
+	"testing"
+)
+
+func Test_Setenv(t *testing.T) {
+	t.Parallel()
+
+	t.Setenv("FOO", "bar") // want `t\.Setenv\(\) cannot be used in Test_Setenv: the test or one of its parents is parallel`
+}
+
+func Test_Chdir(t *testing.T) {
+	t.Chdir("foo") // want `t\.Chdir\(\) cannot be used in Test_Chdir: the test or one of its parents is parallel`
+
+	t.Parallel()
+}
+
+func Test_Subtest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("foo", func(t *testing.T) {
+		t.Setenv("FOO", "bar") // want `t\.Setenv\(\) cannot be used in Test_Subtest/foo: the test or one of its parents is parallel`
+
+		t.Run("bar", func(t *testing.T) {
+			t.Chdir("foo") // want `t\.Chdir\(\) cannot be used in Test_Subtest/foo/bar: the test or one of its parents is parallel`
+		})
+	})
+}
+
+func Test_Subtest_parallel(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	t.Run("foo", func(t *testing.T) {
+		t.Parallel()
+
+		t.Setenv("FOO", "bar") // want `t\.Setenv\(\) cannot be used in Test_Subtest_parallel/foo: the test or one of its parents is parallel`
+	})
+
+	t.Run("bar", func(t *testing.T) {
+		t.Setenv("FOO", "bar")
+	})
+}
+
+func Test_Sequential(t *testing.T) {
+	t.Setenv("FOO", "bar")
+	t.Chdir("foo")
+}
+
+func Benchmark_Setenv(b *testing.B) {
+	b.Setenv("FOO", "bar")
+}
@@ -0,0 +1,78 @@
+package disable
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Error(t *testing.T) {
+	err := errors.New("foo")
+	if err != nil {
+		t.Error(err)
+		t.FailNow()
+	}
+}
+
+func Test_Errorf(t *testing.T) {
+	err := errors.New("foo")
+	if err != nil {
+		t.Errorf("foo: %v", err)
+		t.FailNow()
+	}
+}
+
+func Test_Log(t *testing.T) {
+	t.Log("foo")
+	t.SkipNow()
+}
+
+func Test_Logf(t *testing.T) {
+	t.Logf("foo %s", "bar")
+	t.SkipNow()
+}
+
+func Test_Switch(t *testing.T) {
+	switch errors.New("foo") {
+	case nil:
+	default:
+		t.Error("foo")
+		t.FailNow()
+	}
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		t.Log("foo")
+		t.SkipNow()
+	})
+}
+
+func Benchmark_Error(b *testing.B) {
+	b.Error("foo")
+	b.FailNow()
+}
+
+func helper(tb testing.TB) {
+	tb.Helper()
+
+	tb.Errorf("foo %s", "bar")
+	tb.FailNow()
+}
+
+func Test_NotConsecutive(t *testing.T) {
+	t.Error("foo")
+	t.Log("bar")
+	t.FailNow()
+}
+
+func Test_Mismatch(t *testing.T) {
+	t.Error("foo")
+	t.SkipNow()
+}
+
+func Test_OtherHandle(t *testing.T) {
+	t.Run("foo", func(tt *testing.T) {
+		t.Error("foo")
+		tt.FailNow()
+	})
+}
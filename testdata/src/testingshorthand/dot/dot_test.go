@@ -0,0 +1,15 @@
+package dot
+
+import (
+	. "testing"
+)
+
+func Test_Errorf(t *T) {
+	t.Errorf("foo %s", "bar") // want `t\.Errorf\(\) and t\.FailNow\(\) could be replaced by t\.Fatalf\(\) in Test_Errorf`
+	t.FailNow()
+}
+
+func Test_Log(t *T) {
+	t.Log("foo") // want `t\.Log\(\) and t\.SkipNow\(\) could be replaced by t\.Skip\(\) in Test_Log`
+	t.SkipNow()
+}
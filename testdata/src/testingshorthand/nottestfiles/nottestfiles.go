@@ -0,0 +1,78 @@
+package nottestfiles
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Error(t *testing.T) {
+	err := errors.New("foo")
+	if err != nil {
+		t.Error(err) // want `t\.Error\(\) and t\.FailNow\(\) could be replaced by t\.Fatal\(\) in Test_Error`
+		t.FailNow()
+	}
+}
+
+func Test_Errorf(t *testing.T) {
+	err := errors.New("foo")
+	if err != nil {
+		t.Errorf("foo: %v", err) // want `t\.Errorf\(\) and t\.FailNow\(\) could be replaced by t\.Fatalf\(\) in Test_Errorf`
+		t.FailNow()
+	}
+}
+
+func Test_Log(t *testing.T) {
+	t.Log("foo") // want `t\.Log\(\) and t\.SkipNow\(\) could be replaced by t\.Skip\(\) in Test_Log`
+	t.SkipNow()
+}
+
+func Test_Logf(t *testing.T) {
+	t.Logf("foo %s", "bar") // want `t\.Logf\(\) and t\.SkipNow\(\) could be replaced by t\.Skipf\(\) in Test_Logf`
+	t.SkipNow()
+}
+
+func Test_Switch(t *testing.T) {
+	switch errors.New("foo") {
+	case nil:
+	default:
+		t.Error("foo") // want `t\.Error\(\) and t\.FailNow\(\) could be replaced by t\.Fatal\(\) in Test_Switch`
+		t.FailNow()
+	}
+}
+
+func Test_SubTest(t *testing.T) {
+	t.Run("foo", func(t *testing.T) {
+		t.Log("foo") // want `t\.Log\(\) and t\.SkipNow\(\) could be replaced by t\.Skip\(\) in Test_SubTest/foo`
+		t.SkipNow()
+	})
+}
+
+func Benchmark_Error(b *testing.B) {
+	b.Error("foo") // want `b\.Error\(\) and b\.FailNow\(\) could be replaced by b\.Fatal\(\) in Benchmark_Error`
+	b.FailNow()
+}
+
+func helper(tb testing.TB) {
+	tb.Helper()
+
+	tb.Errorf("foo %s", "bar") // want `tb\.Errorf\(\) and tb\.FailNow\(\) could be replaced by tb\.Fatalf\(\) in helper`
+	tb.FailNow()
+}
+
+func Test_NotConsecutive(t *testing.T) {
+	t.Error("foo")
+	t.Log("bar")
+	t.FailNow()
+}
+
+func Test_Mismatch(t *testing.T) {
+	t.Error("foo")
+	t.SkipNow()
+}
+
+func Test_OtherHandle(t *testing.T) {
+	t.Run("foo", func(tt *testing.T) {
+		t.Error("foo")
+		tt.FailNow()
+	})
+}
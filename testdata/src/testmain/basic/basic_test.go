@@ -0,0 +1,10 @@
+package basic
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(m.Run()) // want `os\.Exit\(m\.Run\(\)\) could be replaced by m\.Run\(\) in TestMain`
+}
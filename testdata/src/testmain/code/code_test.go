@@ -0,0 +1,17 @@
+package code
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if code != 0 {
+		println("failed")
+	}
+
+	os.Exit(code)
+}
+
+func Test_Foo(t *testing.T) {}
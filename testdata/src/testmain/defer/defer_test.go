@@ -0,0 +1,17 @@
+package deferred
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "foo")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	os.Exit(m.Run()) // want `os\.Exit\(m\.Run\(\)\) could be replaced by m\.Run\(\) in TestMain`
+}
@@ -0,0 +1,10 @@
+package dot
+
+import (
+	. "os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	Exit(m.Run()) // want `os\.Exit\(m\.Run\(\)\) could be replaced by m\.Run\(\) in TestMain`
+}
@@ -0,0 +1,20 @@
+package nottestfiles
+
+import (
+	"os"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(m.Run()) // want `os\.Exit\(m\.Run\(\)\) could be replaced by m\.Run\(\) in TestMain`
+}
+
+func setup(m *testing.M) {
+	os.Exit(m.Run())
+}
+
+type foo struct{}
+
+func (foo) TestMain(m *testing.M) {
+	os.Exit(m.Run())
+}
@@ -0,0 +1,61 @@
+package basic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Dir(t *testing.T) {
+	os.WriteFile(filepath.Join("/tmp", "foo"), nil, 0o600) // want `"/tmp" could be replaced by t\.TempDir\(\) in Test_Dir`
+}
+
+func Test_SubPath(t *testing.T) {
+	os.WriteFile("/tmp/foo/bar.txt", nil, 0o600) // want `"/tmp/foo/bar\.txt" could be replaced by t\.TempDir\(\) in .+`
+}
+
+func Test_VarTmp(t *testing.T) {
+	dir := "/var/tmp/" // want `"/var/tmp/" could be replaced by t\.TempDir\(\) in .+`
+	_ = dir
+}
+
+func Test_Const(t *testing.T) {
+	const dir = "/tmp/foo" // want `"/tmp/foo" could be replaced by t\.TempDir\(\) in Test_Const \(no suggested fix: a constant cannot be replaced by a call\)`
+	_ = dir
+}
+
+func Test_NoName(_ *testing.T) {
+	_ = "/tmp" // want `"/tmp" could be replaced by <t/b>\.TempDir\(\) in .+`
+}
+
+func Benchmark_Dir(b *testing.B) {
+	_ = "/tmp" // want `"/tmp" could be replaced by b\.TempDir\(\) in .+`
+}
+
+func Test_Subtest(t *testing.T) {
+	t.Run("foo", func(st *testing.T) {
+		_ = "/tmp" // want `"/tmp" could be replaced by st\.TempDir\(\) in Test_Subtest/foo`
+	})
+}
+
+func Test_Other(t *testing.T) {
+	_ = "/tmpfoo"
+	_ = "tmp/foo"
+	_ = "/usr/tmp"
+	_ = `/tmp` // want "`/tmp` could be replaced by t\\.TempDir\\(\\) in .+"
+}
+
+func foobar() {
+	_ = "/tmp"
+}
+
+func Test_Repeated(t *testing.T) {
+	os.WriteFile("/tmp/foo", nil, 0o600) // want `"/tmp/foo" could be replaced by t\.TempDir\(\) in Test_Repeated \(no suggested fix: the function uses several paths inside the temporary directory\)`
+	os.ReadFile("/tmp/foo")              // want `"/tmp/foo" could be replaced by t\.TempDir\(\) in Test_Repeated \(no suggested fix: the function uses several paths inside the temporary directory\)`
+}
+
+func Test_Concatenated(t *testing.T) {
+	name := "foo"
+	_ = "/tmp/" + name       // want `"/tmp/" could be replaced by t\.TempDir\(\) in Test_Concatenated \(no suggested fix: the path is concatenated\)`
+	_ = ("/tmp/sub/") + name // want `"/tmp/sub/" could be replaced by t\.TempDir\(\) in Test_Concatenated \(no suggested fix: the path is concatenated\)`
+}
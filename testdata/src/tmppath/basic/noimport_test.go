@@ -0,0 +1,9 @@
+package basic
+
+import (
+	"testing"
+)
+
+func Test_NoImport(t *testing.T) {
+	_ = "/tmp/foo" // want `"/tmp/foo" could be replaced by t\.TempDir\(\) in .+`
+}
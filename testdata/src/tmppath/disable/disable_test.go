@@ -0,0 +1,50 @@
+package disable
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Dir(t *testing.T) {
+	os.WriteFile(filepath.Join("/tmp", "foo"), nil, 0o600)
+}
+
+func Test_SubPath(t *testing.T) {
+	os.WriteFile("/tmp/foo/bar.txt", nil, 0o600)
+}
+
+func Test_VarTmp(t *testing.T) {
+	dir := "/var/tmp/"
+	_ = dir
+}
+
+func Test_Const(t *testing.T) {
+	const dir = "/tmp/foo"
+	_ = dir
+}
+
+func Test_NoName(_ *testing.T) {
+	_ = "/tmp"
+}
+
+func Benchmark_Dir(b *testing.B) {
+	_ = "/tmp"
+}
+
+func Test_Subtest(t *testing.T) {
+	t.Run("foo", func(st *testing.T) {
+		_ = "/tmp"
+	})
+}
+
+func Test_Other(t *testing.T) {
+	_ = "/tmpfoo"
+	_ = "tmp/foo"
+	_ = "/usr/tmp"
+	_ = `/tmp`
+}
+
+func foobar() {
+	_ = "/tmp"
+}
@@ -0,0 +1,10 @@
+package dot
+
+import (
+	. "path/filepath"
+	"testing"
+)
+
+func Test_SubPath(t *testing.T) {
+	_ = Clean("/tmp/foo") // want `"/tmp/foo" could be replaced by t\.TempDir\(\) in .+`
+}
@@ -0,0 +1,50 @@
+package nottestfiles
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Dir(t *testing.T) {
+	os.WriteFile(filepath.Join("/tmp", "foo"), nil, 0o600) // want `"/tmp" could be replaced by t\.TempDir\(\) in Test_Dir`
+}
+
+func Test_SubPath(t *testing.T) {
+	os.WriteFile("/tmp/foo/bar.txt", nil, 0o600) // want `"/tmp/foo/bar\.txt" could be replaced by t\.TempDir\(\) in .+`
+}
+
+func Test_VarTmp(t *testing.T) {
+	dir := "/var/tmp/" // want `"/var/tmp/" could be replaced by t\.TempDir\(\) in .+`
+	_ = dir
+}
+
+func Test_Const(t *testing.T) {
+	const dir = "/tmp/foo" // want `"/tmp/foo" could be replaced by t\.TempDir\(\) in .+`
+	_ = dir
+}
+
+func Test_NoName(_ *testing.T) {
+	_ = "/tmp" // want `"/tmp" could be replaced by <t/b>\.TempDir\(\) in .+`
+}
+
+func Benchmark_Dir(b *testing.B) {
+	_ = "/tmp" // want `"/tmp" could be replaced by b\.TempDir\(\) in .+`
+}
+
+func Test_Subtest(t *testing.T) {
+	t.Run("foo", func(st *testing.T) {
+		_ = "/tmp" // want `"/tmp" could be replaced by st\.TempDir\(\) in Test_Subtest/foo`
+	})
+}
+
+func Test_Other(t *testing.T) {
+	_ = "/tmpfoo"
+	_ = "tmp/foo"
+	_ = "/usr/tmp"
+	_ = `/tmp` // want "`/tmp` could be replaced by t\\.TempDir\\(\\) in .+"
+}
+
+func foobar() {
+	_ = "/tmp"
+}
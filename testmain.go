@@ -0,0 +1,86 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// testMainParam returns the parameter of `func TestMain(m *testing.M)`.
+func testMainParam(pass *analysis.Pass, fn *ast.FuncDecl) (*ast.Ident, bool) {
+	if fn.Recv != nil || fn.Name.Name != testMainName || fn.Body == nil {
+		return nil, false
+	}
+
+	params := fn.Type.Params.List
+	if len(params) != 1 || len(params[0].Names) != 1 || !isNamed(params[0].Names[0]) {
+		return nil, false
+	}
+
+	ptr, ok := types.Unalias(pass.TypesInfo.TypeOf(params[0].Type)).(*types.Pointer)
+	if !ok {
+		return nil, false
+	}
+
+	named, ok := types.Unalias(ptr.Elem()).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != testingPkgName || named.Obj().Name() != "M" {
+		return nil, false
+	}
+
+	return params[0].Names[0], true
+}
+
+// reportTestMain reports the call to `os.Exit` wrapping `m.Run()` at the end of `TestMain`:
+// since go1.15, the exit code of `m.Run()` is used when `TestMain` returns.
+//
+//	os.Exit(m.Run())
+//
+// becomes:
+//
+//	m.Run()
+//
+// The deferred functions of `TestMain` are also called.
+func reportTestMain(pass *analysis.Pass, fn *ast.FuncDecl, m *ast.Ident) {
+	stmts := fn.Body.List
+	if len(stmts) == 0 {
+		return
+	}
+
+	// The statements after the call are unreachable: only the last statement is replaced.
+	es, ok := stmts[len(stmts)-1].(*ast.ExprStmt)
+	if !ok {
+		return
+	}
+
+	exit, ok := isFuncCall(pass, es.X, osPkgName, exitName)
+	if !ok || len(exit.Args) != 1 {
+		return
+	}
+
+	run, ok := isFuncCall(pass, exit.Args[0], testingPkgName, runName)
+	if !ok || len(run.Args) != 0 {
+		return
+	}
+
+	se, ok := run.Fun.(*ast.SelectorExpr)
+	if !ok || !isIdentOf(pass, se.X, pass.TypesInfo.ObjectOf(m)) {
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos: exit.Pos(),
+		End: exit.End(),
+		Message: fmt.Sprintf("%s.%s(%s) could be replaced by %s in %s",
+			osPkgName, exitName, types.ExprString(run), types.ExprString(run), fn.Name.Name,
+		),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			TextEdits: []analysis.TextEdit{{
+				Pos:     exit.Pos(),
+				End:     exit.End(),
+				NewText: []byte(types.ExprString(run)),
+			}},
+		}},
+	})
+}
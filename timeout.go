@@ -0,0 +1,60 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// reportContextTimeout reports the contexts with a constant timeout:
+// the timeout can exceed the deadline of the test (`go test -timeout`), which ends with a panic instead of a clean failure.
+//
+//	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+func reportContextTimeout(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	// Only `*testing.T` has a deadline.
+	if !hasMethod(fnInfo.Type, deadlineName) {
+		return
+	}
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			return !ignored[fn]
+		}
+
+		call, ok := isFuncCall(pass, n, contextPkgName, withTimeoutName, withTimeoutCauseName)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+
+		if tv, ok := pass.TypesInfo.Types[call.Args[1]]; !ok || tv.Value == nil {
+			return true
+		}
+
+		fn := typeutil.Callee(pass.TypesInfo, call)
+
+		pass.Report(analysis.Diagnostic{
+			Pos: call.Pos(),
+			End: call.End(),
+			Message: fmt.Sprintf("%s.%s() could derive its timeout from %s.%s() in %s",
+				contextPkgName, fn.Name(), fnInfo.ArgName, deadlineName, fnInfo.Name,
+			),
+		})
+
+		return true
+	})
+}
+
+func hasMethod(typ types.Type, name string) bool {
+	if typ == nil {
+		return false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, name)
+
+	_, ok := obj.(*types.Func)
+
+	return ok
+}
@@ -0,0 +1,188 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// tmpDirs are the usual absolute paths of the system temporary directory.
+var tmpDirs = []string{"/tmp", "/var/tmp"}
+
+const (
+	reasonConstant     = "a constant cannot be replaced by a call"
+	reasonConcatenated = "the path is concatenated"
+	reasonSeveralPaths = "the function uses several paths inside the temporary directory"
+)
+
+// tmpLit is a hardcoded path inside the system temporary directory.
+type tmpLit struct {
+	lit *ast.BasicLit
+
+	// sub is the part of the path inside the system temporary directory.
+	sub string
+
+	// reason explains why there is no fix.
+	reason string
+}
+
+// reportTmpPath reports the hardcoded paths inside the system temporary directory:
+//
+//	"/tmp/foo"
+//	filepath.Join("/tmp", "foo")
+//
+// Each call to `t.TempDir()` returns a new directory:
+// the fix is only suggested when the function uses a single path.
+func reportTmpPath(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool, fnInfo *FuncInfo) {
+	lits := findTmpLits(pass, block, ignored)
+
+	for _, tl := range lits {
+		if tl.reason == "" && len(lits) > 1 {
+			tl.reason = reasonSeveralPaths
+		}
+
+		reportTmpLit(pass, tl, fnInfo)
+	}
+}
+
+// findTmpLits finds the hardcoded paths inside the system temporary directory.
+func findTmpLits(pass *analysis.Pass, block *ast.BlockStmt, ignored map[*ast.FuncLit]bool) []*tmpLit {
+	var lits []*tmpLit
+
+	// The operands of the concatenations.
+	concatenated := make(map[ast.Expr]bool)
+
+	var inspect func(node ast.Node, reason string)
+
+	inspect = func(node ast.Node, reason string) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.FuncLit:
+				return !ignored[v]
+
+			case *ast.GenDecl:
+				if v.Tok == token.CONST {
+					for _, spec := range v.Specs {
+						inspect(spec, reasonConstant)
+					}
+
+					return false
+				}
+
+			case *ast.BinaryExpr:
+				if v.Op == token.ADD {
+					concatenated[ast.Unparen(v.X)] = true
+					concatenated[ast.Unparen(v.Y)] = true
+				}
+
+			case *ast.BasicLit:
+				tl, ok := newTmpLit(v)
+				if !ok {
+					return true
+				}
+
+				switch {
+				case reason != "":
+					tl.reason = reason
+				case concatenated[v]:
+					tl.reason = reasonConcatenated
+				}
+
+				lits = append(lits, tl)
+			}
+
+			return true
+		})
+	}
+
+	inspect(block, "")
+
+	return lits
+}
+
+func newTmpLit(lit *ast.BasicLit) (*tmpLit, bool) {
+	if lit.Kind != token.STRING {
+		return nil, false
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil, false
+	}
+
+	sub, ok := tmpSubPath(value)
+	if !ok {
+		return nil, false
+	}
+
+	return &tmpLit{lit: lit, sub: sub}, true
+}
+
+func reportTmpLit(pass *analysis.Pass, tl *tmpLit, fnInfo *FuncInfo) {
+	diagnostic := analysis.Diagnostic{
+		Pos: tl.lit.Pos(),
+		End: tl.lit.End(),
+		Message: fmt.Sprintf("%s could be replaced by %s.%s() in %s",
+			tl.lit.Value, fnInfo.ArgName, tempDirName, fnInfo.Name,
+		),
+	}
+
+	if tl.reason != "" {
+		diagnostic.Message += fmt.Sprintf(" (no suggested fix: %s)", tl.reason)
+	} else if fix := tmpPathFix(pass, tl.lit, tl.sub, fnInfo); fix != "" {
+		diagnostic.SuggestedFixes = append(diagnostic.SuggestedFixes, analysis.SuggestedFix{
+			TextEdits: []analysis.TextEdit{{
+				Pos:     tl.lit.Pos(),
+				End:     tl.lit.End(),
+				NewText: []byte(fix),
+			}},
+		})
+	}
+
+	pass.Report(diagnostic)
+}
+
+// tmpSubPath returns the part of the path inside the system temporary directory.
+func tmpSubPath(value string) (string, bool) {
+	for _, dir := range tmpDirs {
+		if value == dir {
+			return "", true
+		}
+
+		if sub, ok := strings.CutPrefix(value, dir+"/"); ok {
+			return strings.Trim(sub, "/"), true
+		}
+	}
+
+	return "", false
+}
+
+// tmpPathFix returns the replacement of a path inside the system temporary directory:
+// `t.TempDir()`, or `filepath.Join(t.TempDir(), "foo")` when the file imports path/filepath.
+func tmpPathFix(pass *analysis.Pass, lit *ast.BasicLit, sub string, fnInfo *FuncInfo) string {
+	if !hasArgName(fnInfo) {
+		return ""
+	}
+
+	tempDir := fnInfo.ArgName + "." + tempDirName + "()"
+
+	if sub == "" {
+		return tempDir
+	}
+
+	name, ok := importName(pass, lit, filepathPkgPath)
+	if !ok {
+		return ""
+	}
+
+	join := joinName
+	if name != "." {
+		join = name + "." + joinName
+	}
+
+	return fmt.Sprintf("%s(%s, %s)", join, tempDir, strconv.Quote(sub))
+}
@@ -0,0 +1,112 @@
+package usetesting
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	reasonUnknownVariables = "the variables to restore are unknown"
+	reasonDeferred         = "the call is deferred"
+)
+
+// collectUnsetenv computes the fixes of `os.Unsetenv` calls:
+//
+//	os.Unsetenv("FOO")
+//
+// becomes:
+//
+//	t.Setenv("FOO", "")
+//	os.Unsetenv("FOO")
+//
+// The call to `t.Setenv` restores the original value at the end of the test.
+func (r *rewrites) collectUnsetenv(pass *analysis.Pass, stmts []ast.Stmt, used map[int]bool, fnInfo *FuncInfo) {
+	for i := range stmts {
+		if used[i] {
+			continue
+		}
+
+		cs := matchCallStmt(pass, stmts, i, osPkgName, unsetenvName)
+		if cs == nil || len(cs.call.Args) != 1 || r.fixes[cs.call.Fun] != nil {
+			continue
+		}
+
+		for j := cs.first; j <= cs.last; j++ {
+			used[j] = true
+		}
+
+		stmt := stmts[cs.first]
+
+		r.add(cs.call.Fun, &rewrite{edits: []analysis.TextEdit{{
+			Pos: stmt.Pos(),
+			End: stmt.Pos(),
+			NewText: fmt.Appendf(nil, "%s.%s(%s, \"\")\n%s",
+				fnInfo.ArgName, setenvName, types.ExprString(cs.call.Args[0]), lineIndent(pass, stmt.Pos()),
+			),
+		}}})
+	}
+}
+
+// skipEnvRestores skips the calls restoring an environment variable set by the function,
+// inside the deferred calls and the cleanup functions:
+//
+//	os.Setenv("FOO", "bar")
+//	defer os.Unsetenv("FOO")
+//
+//	os.Unsetenv("FOO")
+//	t.Cleanup(func() { os.Unsetenv("FOO") })
+//
+// The calls without a matching `os.Setenv` or `os.Unsetenv` are reported without suggested fix:
+// they leak into the environment of the other tests.
+func (r *rewrites) skipEnvRestores(pass *analysis.Pass, block *ast.BlockStmt) {
+	var (
+		// keys are the environment variables set by the function.
+		keys []ast.Expr
+
+		deferred []*ast.CallExpr
+	)
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.DeferStmt:
+			deferred = append(deferred, v.Call)
+
+			return false
+
+		case *ast.CallExpr:
+			if _, ok := isFuncCall(pass, v, testingPkgName, cleanupName); ok {
+				deferred = append(deferred, v)
+
+				return false
+			}
+
+			if call, ok := isFuncCall(pass, v, osPkgName, setenvName, unsetenvName); ok && len(call.Args) > 0 {
+				keys = append(keys, call.Args[0])
+			}
+		}
+
+		return true
+	})
+
+	for _, call := range deferred {
+		ast.Inspect(call, func(n ast.Node) bool {
+			restore, ok := isFuncCall(pass, n, osPkgName, unsetenvName)
+			if !ok || len(restore.Args) != 1 {
+				return true
+			}
+
+			if slices.ContainsFunc(keys, func(key ast.Expr) bool { return sameExpr(key, restore.Args[0]) }) {
+				r.skipped[restore.Fun] = true
+			} else {
+				// `t.Setenv()` cannot be called from a deferred call or a cleanup function.
+				r.add(restore.Fun, &rewrite{reason: reasonDeferred})
+			}
+
+			return true
+		})
+	}
+}
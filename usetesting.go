@@ -4,6 +4,9 @@ package usetesting
 import (
 	"go/ast"
 	"go/build"
+	"go/token"
+	"go/types"
+	"maps"
 	"os"
 	"slices"
 	"strconv"
@@ -15,37 +18,113 @@ import (
 )
 
 const (
-	chdirName      = "Chdir"
-	mkdirTempName  = "MkdirTemp"
-	createTempName = "CreateTemp"
-	setenvName     = "Setenv"
-	tempDirName    = "TempDir"
-	backgroundName = "Background"
-	todoName       = "TODO"
-	contextName    = "Context"
+	chdirName            = "Chdir"
+	mkdirTempName        = "MkdirTemp"
+	createTempName       = "CreateTemp"
+	tempFileName         = "TempFile"
+	setenvName           = "Setenv"
+	tempDirName          = "TempDir"
+	backgroundName       = "Background"
+	todoName             = "TODO"
+	contextName          = "Context"
+	unsetenvName         = "Unsetenv"
+	clearenvName         = "Clearenv"
+	getenvName           = "Getenv"
+	lookupEnvName        = "LookupEnv"
+	cleanupName          = "Cleanup"
+	removeAllName        = "RemoveAll"
+	removeName           = "Remove"
+	mkdirAllName         = "MkdirAll"
+	mkdirName            = "Mkdir"
+	getwdName            = "Getwd"
+	runName              = "Run"
+	parallelName         = "Parallel"
+	waitName             = "Wait"
+	joinName             = "Join"
+	withCancelName       = "WithCancel"
+	withTimeoutName      = "WithTimeout"
+	withTimeoutCauseName = "WithTimeoutCause"
+	deadlineName         = "Deadline"
+	loopName             = "Loop"
+	resetTimerName       = "ResetTimer"
+	nName                = "N"
+	outputName           = "Output"
+	stdoutName           = "Stdout"
+	stderrName           = "Stderr"
+	newName              = "New"
+	setOutputName        = "SetOutput"
+	newTextHandlerName   = "NewTextHandler"
+	newJSONHandlerName   = "NewJSONHandler"
+	printName            = "Print"
+	printfName           = "Printf"
+	printlnName          = "Println"
+	logName              = "Log"
+	logfName             = "Logf"
+	exitName             = "Exit"
+	fatalName            = "Fatal"
+	fatalfName           = "Fatalf"
+	fatallnName          = "Fatalln"
+	panicName            = "Panic"
+	panicfName           = "Panicf"
+	paniclnName          = "Panicln"
+	panicBuiltinName     = "panic"
+	testMainName         = "TestMain"
+	errorName            = "Error"
+	errorfName           = "Errorf"
+	failNowName          = "FailNow"
+	skipName             = "Skip"
+	skipfName            = "Skipf"
+	skipNowName          = "SkipNow"
 )
 
 const (
-	osPkgName      = "os"
-	contextPkgName = "context"
-	testingPkgName = "testing"
+	osPkgName       = "os"
+	contextPkgName  = "context"
+	testingPkgName  = "testing"
+	ioutilPkgName   = "ioutil"
+	ioutilPkgPath   = "io/ioutil"
+	filepathPkgPath = "path/filepath"
+	pathPkgPath     = "path"
+	logPkgPath      = "log"
+	slogPkgPath     = "log/slog"
+	fmtPkgName      = "fmt"
 )
 
 // FuncInfo information about the test function.
 type FuncInfo struct {
 	Name    string
 	ArgName string
+
+	// Type is the type of the testing handle.
+	Type types.Type
+
+	// Parallel is true when the test or one of its parents calls `t.Parallel()`.
+	Parallel bool
+
+	// TestFunc is true when the function is the test itself (a test function or a subtest function), and not a helper.
+	TestFunc bool
 }
 
 // analyzer is the UseTesting linter.
 type analyzer struct {
 	contextBackground bool
 	contextTodo       bool
+	contextCleanup    bool
+	contextTimeout    bool
 	osChdir           bool
 	osMkdirTemp       bool
 	osTempDir         bool
 	osSetenv          bool
+	osUnsetenv        bool
 	osCreateTemp      bool
+	testingParallel   bool
+	bLoop             bool
+	testingOutput     bool
+	fmtPrint          bool
+	testingFatal      bool
+	testMain          bool
+	testingShorthand  bool
+	tmpPath           bool
 
 	fieldNames []string
 
@@ -65,6 +144,8 @@ func NewAnalyzer() *analysis.Analyzer {
 			backgroundName,
 			todoName,
 			createTempName,
+			unsetenvName,
+			clearenvName,
 		},
 		skipGoVersionDetection: skip,
 	}
@@ -78,21 +159,34 @@ func NewAnalyzer() *analysis.Analyzer {
 
 	a.Flags.BoolVar(&l.contextBackground, "contextbackground", false, "Enable/disable context.Background() detections")
 	a.Flags.BoolVar(&l.contextTodo, "contexttodo", false, "Enable/disable context.TODO() detections")
+	a.Flags.BoolVar(&l.contextCleanup, "contextcleanup", true, "Enable/disable t.Context() detections inside cleanup functions")
+	a.Flags.BoolVar(&l.contextTimeout, "contexttimeout", false, "Enable/disable constant context.WithTimeout() detections")
 	a.Flags.BoolVar(&l.osChdir, "oschdir", true, "Enable/disable os.Chdir() detections")
-	a.Flags.BoolVar(&l.osMkdirTemp, "osmkdirtemp", true, "Enable/disable os.MkdirTemp() detections")
+	a.Flags.BoolVar(&l.osMkdirTemp, "osmkdirtemp", true, "Enable/disable os.MkdirTemp() and ioutil.TempDir() detections")
 	a.Flags.BoolVar(&l.osSetenv, "ossetenv", false, "Enable/disable os.Setenv() detections")
+	a.Flags.BoolVar(&l.osUnsetenv, "osunsetenv", false, "Enable/disable os.Unsetenv() and os.Clearenv() detections")
 	a.Flags.BoolVar(&l.osTempDir, "ostempdir", false, "Enable/disable os.TempDir() detections")
-	a.Flags.BoolVar(&l.osCreateTemp, "oscreatetemp", true, `Enable/disable os.CreateTemp("", ...) detections`)
+	a.Flags.BoolVar(&l.osCreateTemp, "oscreatetemp", true, `Enable/disable os.CreateTemp("", ...) and ioutil.TempFile("", ...) detections`)
+	a.Flags.BoolVar(&l.testingParallel, "testingparallel", true, "Enable/disable t.Setenv() and t.Chdir() detections inside parallel tests")
+	a.Flags.BoolVar(&l.bLoop, "bloop", false, "Enable/disable loops over b.N detections")
+	a.Flags.BoolVar(&l.testingOutput, "testingoutput", false, "Enable/disable os.Stdout and os.Stderr loggers detections")
+	a.Flags.BoolVar(&l.fmtPrint, "fmtprint", false, "Enable/disable fmt.Print(), fmt.Printf(), and fmt.Println() detections")
+	a.Flags.BoolVar(&l.testingFatal, "testingfatal", false, "Enable/disable os.Exit(), log.Fatal*(), log.Panic*(), and panic(err) detections")
+	a.Flags.BoolVar(&l.testMain, "testmain", false, "Enable/disable os.Exit(m.Run()) detections inside TestMain")
+	a.Flags.BoolVar(&l.testingShorthand, "testingshorthand", false, "Enable/disable t.Error()+t.FailNow() and t.Log()+t.SkipNow() detections")
+	a.Flags.BoolVar(&l.tmpPath, "tmppath", false, "Enable/disable hardcoded /tmp paths detections")
 
 	return a
 }
 
 func (a *analyzer) run(pass *analysis.Pass) (any, error) {
-	if !a.contextBackground && !a.contextTodo && !a.osChdir && !a.osMkdirTemp && !a.osSetenv && !a.osTempDir && !a.osCreateTemp {
+	if !a.contextBackground && !a.contextTodo && !a.contextCleanup && !a.contextTimeout && !a.osChdir && !a.osMkdirTemp && !a.osSetenv && !a.osUnsetenv && !a.osTempDir && !a.osCreateTemp && !a.testingParallel && !a.bLoop && !a.testingOutput && !a.fmtPrint && !a.testingFatal && !a.testMain && !a.testingShorthand && !a.tmpPath {
 		return nil, nil
 	}
 
-	geGo124 := a.isGoSupported(pass)
+	geGo115 := a.isGoSupported(pass, 115)
+	geGo124 := a.isGoSupported(pass, 124)
+	geGo125 := a.isGoSupported(pass, 125)
 
 	insp, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
@@ -111,14 +205,14 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 
 		switch fn := node.(type) {
 		case *ast.FuncDecl:
-			a.checkFunc(pass, fn.Type, fn.Body, fn.Name.Name, geGo124)
-
-		case *ast.FuncLit:
-			if hasParentFunc(stack) {
-				return true
+			if m, ok := testMainParam(pass, fn); ok && geGo115 && a.testMain {
+				reportTestMain(pass, fn, m)
 			}
 
-			a.checkFunc(pass, fn.Type, fn.Body, "anonymous function", geGo124)
+			a.checkFunc(pass, fn.Recv, fn.Type, fn.Body, fn.Name.Name, stack, geGo124, geGo125)
+
+		case *ast.FuncLit:
+			a.checkFunc(pass, nil, fn.Type, fn.Body, funcLitName(pass, stack), stack, geGo124, geGo125)
 		}
 
 		return true
@@ -127,23 +221,41 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 	return nil, nil
 }
 
-func (a *analyzer) checkFunc(pass *analysis.Pass, ft *ast.FuncType, block *ast.BlockStmt, fnName string, geGo124 bool) {
-	if len(ft.Params.List) < 1 {
+func (a *analyzer) checkFunc(pass *analysis.Pass, recv *ast.FieldList, ft *ast.FuncType, block *ast.BlockStmt, fnName string, stack []ast.Node, geGo124, geGo125 bool) {
+	fnInfo := findTestHandle(pass, recv, ft, fnName)
+	if fnInfo == nil {
 		return
 	}
 
-	fnInfo := checkTestFunctionSignature(ft.Params.List[0], fnName)
-	if fnInfo == nil {
-		return
+	fnInfo.Parallel = isParallel(pass, stack)
+	fnInfo.TestFunc = isTestFunc(pass, stack)
+
+	// The nested test functions are checked separately, with their own testing handle.
+	ignored := nestedTestFuncs(pass, block)
+
+	// The testing handle cannot be used by the functions running after the end of the test.
+	escaping := escapingFuncs(pass, block, ignored)
+	maps.Copy(ignored, escaping)
+
+	rws := a.collectRewrites(pass, block, fnInfo, ignored)
+
+	cleanups := cleanupFuncs(pass, block, ignored)
+
+	// The testing context is canceled before the cleanup functions run.
+	for _, cleanup := range cleanups {
+		rws.skip(pass, cleanup.fn.Body, contextPkgName, backgroundName, todoName)
 	}
 
 	ast.Inspect(block, func(n ast.Node) bool {
 		switch v := n.(type) {
+		case *ast.FuncLit:
+			return !ignored[v]
+
 		case *ast.SelectorExpr:
-			return !a.reportSelector(pass, v, fnInfo, geGo124)
+			return !a.reportSelector(pass, v, fnInfo, rws, geGo124)
 
 		case *ast.Ident:
-			return !a.reportIdent(pass, v, fnInfo, geGo124)
+			return !a.reportIdent(pass, v, fnInfo, rws, geGo124)
 
 		case *ast.CallExpr:
 			return !a.reportCallExpr(pass, v, fnInfo)
@@ -151,9 +263,46 @@ func (a *analyzer) checkFunc(pass *analysis.Pass, ft *ast.FuncType, block *ast.B
 
 		return true
 	})
+
+	if geGo124 && a.contextCleanup {
+		reportCleanupContext(pass, cleanups, fnInfo)
+	}
+
+	if a.contextTimeout {
+		reportContextTimeout(pass, block, ignored, fnInfo)
+	}
+
+	if geGo124 && a.bLoop {
+		reportBLoop(pass, block, ignored, fnInfo)
+	}
+
+	if geGo125 && a.testingOutput {
+		reportOutput(pass, block, ignored, fnInfo)
+	}
+
+	if a.fmtPrint {
+		reportFmtPrint(pass, block, ignored, fnInfo)
+	}
+
+	if a.testingFatal {
+		reportExit(pass, ft, block, ignored, escaping, fnInfo)
+	}
+
+	if a.testingShorthand {
+		reportShorthand(pass, block, ignored, fnInfo)
+	}
+
+	if a.tmpPath {
+		reportTmpPath(pass, block, ignored, fnInfo)
+	}
+
+	if a.testingParallel && fnInfo.Parallel {
+		reportParallel(pass, block, ignored, fnInfo)
+	}
 }
 
-func (a *analyzer) isGoSupported(pass *analysis.Pass) bool {
+// isGoSupported checks if the Go version of the package is greater than or equal to the minimal version (ex: 124 for go1.24).
+func (a *analyzer) isGoSupported(pass *analysis.Pass, minVersion int) bool {
 	if a.skipGoVersionDetection {
 		return true
 	}
@@ -189,57 +338,187 @@ func (a *analyzer) isGoSupported(pass *analysis.Pass) bool {
 		v = 116
 	}
 
-	return v >= 124
+	return v >= minVersion
+}
+
+// nestedTestFuncs returns the function literals having their own testing handle (subtests, `f.Fuzz` functions, ...).
+func nestedTestFuncs(pass *analysis.Pass, block *ast.BlockStmt) map[*ast.FuncLit]bool {
+	nested := make(map[*ast.FuncLit]bool)
+
+	ast.Inspect(block, func(n ast.Node) bool {
+		fn, ok := n.(*ast.FuncLit)
+		if !ok || findTestHandle(pass, nil, fn.Type, "") == nil {
+			return true
+		}
+
+		nested[fn] = true
+
+		// The deeper functions are handled by the check of this function.
+		return false
+	})
+
+	return nested
 }
 
-func hasParentFunc(stack []ast.Node) bool {
-	// -2 because the last parent is the node.
-	const skipSelf = 2
+// isTestFunc checks if the function is a test function (`TestXxx`, `BenchmarkXxx`, `FuzzXxx`), or a subtest function (`t.Run`).
+// The stack contains the enclosing nodes of the function, and the function itself.
+func isTestFunc(pass *analysis.Pass, stack []ast.Node) bool {
+	switch fn := stack[len(stack)-1].(type) {
+	case *ast.FuncDecl:
+		if fn.Recv != nil {
+			return false
+		}
 
-	// skip 0 because it's always [*ast.File].
-	for i := len(stack) - skipSelf; i > 0; i-- {
-		s := stack[i]
+		return strings.HasPrefix(fn.Name.Name, "Test") || strings.HasPrefix(fn.Name.Name, "Benchmark") || strings.HasPrefix(fn.Name.Name, "Fuzz")
 
-		switch fn := s.(type) {
-		case *ast.FuncDecl:
-			if len(fn.Type.Params.List) < 1 {
-				continue
-			}
+	case *ast.FuncLit:
+		if len(stack) < 2 {
+			return false
+		}
 
-			if checkTestFunctionSignature(fn.Type.Params.List[0], fn.Name.Name) != nil {
-				return true
-			}
+		call, ok := isFuncCall(pass, stack[len(stack)-2], testingPkgName, runName)
 
-		case *ast.FuncLit:
-			if len(fn.Type.Params.List) < 1 {
-				continue
-			}
+		return ok && len(call.Args) == 2 && call.Args[1] == fn
 
-			if checkTestFunctionSignature(fn.Type.Params.List[0], "anonymous function") != nil {
-				return true
-			}
+	default:
+		return false
+	}
+}
+
+// funcLitName returns the name of a function literal:
+// the name of the subtest when the function is called by `t.Run` with a string literal (ex: `TestFoo/bar`).
+func funcLitName(pass *analysis.Pass, stack []ast.Node) string {
+	const anonymous = "anonymous function"
+
+	// -2 because the last element is the function literal.
+	if len(stack) < 2 {
+		return anonymous
+	}
+
+	call, ok := isFuncCall(pass, stack[len(stack)-2], testingPkgName, runName)
+	if !ok || len(call.Args) != 2 || call.Args[1] != stack[len(stack)-1] {
+		return anonymous
+	}
+
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return anonymous
+	}
+
+	name, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return anonymous
+	}
+
+	for i := len(stack) - 3; i > 0; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncDecl:
+			return fn.Name.Name + "/" + name
+
+		case *ast.FuncLit:
+			return funcLitName(pass, stack[:i+1]) + "/" + name
 		}
 	}
 
-	return false
+	return name
 }
 
-func checkTestFunctionSignature(arg *ast.Field, fnName string) *FuncInfo {
-	switch at := arg.Type.(type) {
-	case *ast.StarExpr:
-		if se, ok := at.X.(*ast.SelectorExpr); ok {
-			return createFuncInfo(arg, "<t/b>", se, testingPkgName, fnName, "T", "B")
+// findTestHandle finds the testing handle of a function:
+// a parameter, or a field of the receiver or of a parameter (ex: `h.t`).
+func findTestHandle(pass *analysis.Pass, recv *ast.FieldList, ft *ast.FuncType, fnName string) *FuncInfo {
+	if fnInfo := checkTestFunctionParams(pass, ft, fnName); fnInfo != nil {
+		return fnInfo
+	}
+
+	if fnInfo := checkTestFunctionFields(pass, recv, fnName); fnInfo != nil {
+		return fnInfo
+	}
+
+	return checkTestFunctionFields(pass, ft.Params, fnName)
+}
+
+// checkTestFunctionFields finds a testing handle held by a struct field of the receiver or of a parameter.
+func checkTestFunctionFields(pass *analysis.Pass, fields *ast.FieldList, fnName string) *FuncInfo {
+	if fields == nil {
+		return nil
+	}
+
+	for _, field := range fields.List {
+		idx := slices.IndexFunc(field.Names, isNamed)
+		if idx < 0 {
+			continue
 		}
 
-	case *ast.SelectorExpr:
-		return createFuncInfo(arg, "tb", at, testingPkgName, fnName, "TB")
+		handle, ok := testingHandleField(pass, pass.TypesInfo.TypeOf(field.Type))
+		if !ok {
+			continue
+		}
+
+		return &FuncInfo{
+			Name:    fnName,
+			ArgName: field.Names[idx].Name + "." + handle.Name(),
+			Type:    handle.Type(),
+		}
 	}
 
 	return nil
 }
 
-func createFuncInfo(arg *ast.Field, defaultName string, se *ast.SelectorExpr, pkgName, fnName string, selectorNames ...string) *FuncInfo {
-	ok := checkSelectorName(se, pkgName, selectorNames...)
+// testingHandleField returns the struct field holding a testing handle.
+func testingHandleField(pass *analysis.Pass, typ types.Type) (*types.Var, bool) {
+	if typ == nil {
+		return nil, false
+	}
+
+	typ = types.Unalias(typ)
+
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = types.Unalias(ptr.Elem())
+	}
+
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return nil, false
+	}
+
+	for field := range st.Fields() {
+		if !field.Exported() && field.Pkg() != pass.Pkg {
+			continue
+		}
+
+		if _, ok := testingHandleName(pass, field.Type()); ok {
+			return field, true
+		}
+	}
+
+	return nil, false
+}
+
+// checkTestFunctionParams finds the testing handle in the parameters, whatever its position.
+// A named handle is preferred over an unnamed one.
+func checkTestFunctionParams(pass *analysis.Pass, ft *ast.FuncType, fnName string) *FuncInfo {
+	var fnInfo *FuncInfo
+
+	for _, param := range ft.Params.List {
+		info := checkTestFunctionSignature(pass, param, fnName)
+		if info == nil {
+			continue
+		}
+
+		if slices.ContainsFunc(param.Names, isNamed) {
+			return info
+		}
+
+		if fnInfo == nil {
+			fnInfo = info
+		}
+	}
+
+	return fnInfo
+}
+
+func checkTestFunctionSignature(pass *analysis.Pass, arg *ast.Field, fnName string) *FuncInfo {
+	defaultName, ok := testingHandleName(pass, pass.TypesInfo.TypeOf(arg.Type))
 	if !ok {
 		return nil
 	}
@@ -247,21 +526,105 @@ func createFuncInfo(arg *ast.Field, defaultName string, se *ast.SelectorExpr, pk
 	return &FuncInfo{
 		Name:    fnName,
 		ArgName: getTestArgName(arg, defaultName),
+		Type:    pass.TypesInfo.TypeOf(arg.Type),
+	}
+}
+
+// testingHandleName returns the default name of a testing handle (`*testing.T`, `*testing.B`, `*testing.F`, `testing.TB`).
+// The type is resolved through the aliases, the type parameters, and the interfaces embedding `testing.TB`.
+func testingHandleName(pass *analysis.Pass, typ types.Type) (string, bool) {
+	if typ == nil {
+		return "", false
+	}
+
+	typ = types.Unalias(typ)
+
+	ptr, isPtr := typ.(*types.Pointer)
+	if isPtr {
+		typ = types.Unalias(ptr.Elem())
+	}
+
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != testingPkgName {
+		if !isPtr && implementsTB(pass, typ) {
+			return "tb", true
+		}
+
+		return "", false
+	}
+
+	switch name := named.Obj().Name(); {
+	case isPtr && (name == "T" || name == "B"):
+		return "<t/b>", true
+
+	case isPtr && name == "F":
+		return "<f>", true
+
+	case !isPtr && name == "TB":
+		return "tb", true
+
+	default:
+		return "", false
+	}
+}
+
+// implementsTB checks if a type parameter or an interface implements `testing.TB`:
+//
+//	func foo[T testing.TB](tb T) {}
+//	func foo(tb interface{ testing.TB; Foo() }) {}
+func implementsTB(pass *analysis.Pass, typ types.Type) bool {
+	if _, ok := typ.(*types.TypeParam); !ok && !types.IsInterface(typ) {
+		return false
 	}
+
+	tb := lookupTestingTB(pass.Pkg)
+
+	return tb != nil && types.Implements(typ, tb)
 }
 
-func checkSelectorName(se *ast.SelectorExpr, pkgName string, selectorNames ...string) bool {
-	if ident, ok := se.X.(*ast.Ident); ok {
-		return pkgName == ident.Name && slices.Contains(selectorNames, se.Sel.Name)
+// lookupTestingTB finds the `testing.TB` interface through the imports of the package.
+func lookupTestingTB(pkg *types.Package) *types.Interface {
+	seen := make(map[*types.Package]bool)
+
+	queue := []*types.Package{pkg}
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		if seen[p] {
+			continue
+		}
+
+		seen[p] = true
+
+		if p.Path() == testingPkgName {
+			obj := p.Scope().Lookup("TB")
+			if obj == nil {
+				return nil
+			}
+
+			iface, _ := obj.Type().Underlying().(*types.Interface)
+
+			return iface
+		}
+
+		queue = append(queue, p.Imports()...)
 	}
 
-	return false
+	return nil
 }
 
 func getTestArgName(arg *ast.Field, defaultName string) string {
-	if len(arg.Names) > 0 && arg.Names[0].Name != "_" {
-		return arg.Names[0].Name
+	// The first named parameter of a group (ex: `a, t *testing.T`).
+	idx := slices.IndexFunc(arg.Names, isNamed)
+	if idx >= 0 {
+		return arg.Names[idx].Name
 	}
 
 	return defaultName
 }
+
+func isNamed(ident *ast.Ident) bool {
+	return ident.Name != "_"
+}
@@ -14,37 +14,116 @@ func TestAnalyzer(t *testing.T) {
 		{dir: "oschdir/basic"},
 		{dir: "oschdir/dot"},
 		{dir: "oschdir/nottestfiles"},
+		{dir: "oschdir/restore"},
+		{dir: "oschdir/parallel"},
 		{dir: "oschdir/disable", options: map[string]string{"oschdir": "false"}},
 
 		{dir: "contextbackground/basic", options: map[string]string{"contextbackground": "true"}},
 		{dir: "contextbackground/dot", options: map[string]string{"contextbackground": "true"}},
 		{dir: "contextbackground/nottestfiles", options: map[string]string{"contextbackground": "true"}},
+		{dir: "contextbackground/cleanup", options: map[string]string{"contextbackground": "true"}},
+		{dir: "contextbackground/derived", options: map[string]string{"contextbackground": "true"}},
 		{dir: "contextbackground/disable"},
 
 		{dir: "contexttodo/basic", options: map[string]string{"contexttodo": "true"}},
 		{dir: "contexttodo/dot", options: map[string]string{"contexttodo": "true"}},
 		{dir: "contexttodo/nottestfiles", options: map[string]string{"contexttodo": "true"}},
+		{dir: "contexttodo/derived", options: map[string]string{"contexttodo": "true"}},
 		{dir: "contexttodo/disable"},
 
+		{dir: "contextcleanup/basic"},
+		{dir: "contextcleanup/dot"},
+		{dir: "contextcleanup/nottestfiles"},
+		{dir: "contextcleanup/disable", options: map[string]string{"contextcleanup": "false"}},
+
+		{dir: "contexttimeout/basic", options: map[string]string{"contexttimeout": "true"}},
+		{dir: "contexttimeout/dot", options: map[string]string{"contexttimeout": "true"}},
+		{dir: "contexttimeout/nottestfiles", options: map[string]string{"contexttimeout": "true"}},
+		{dir: "contexttimeout/disable"},
+
 		{dir: "osmkdirtemp/basic"},
 		{dir: "osmkdirtemp/dot"},
 		{dir: "osmkdirtemp/nottestfiles"},
+		{dir: "osmkdirtemp/cleanup"},
+		{dir: "osmkdirtemp/ioutil"},
+		{dir: "osmkdirtemp/parent"},
 		{dir: "osmkdirtemp/disable", options: map[string]string{"osmkdirtemp": "false"}},
 
 		{dir: "ossetenv/basic", options: map[string]string{"ossetenv": "true"}},
 		{dir: "ossetenv/dot", options: map[string]string{"ossetenv": "true"}},
 		{dir: "ossetenv/nottestfiles", options: map[string]string{"ossetenv": "true"}},
+		{dir: "ossetenv/restore", options: map[string]string{"ossetenv": "true"}},
+		{dir: "ossetenv/parallel", options: map[string]string{"ossetenv": "true"}},
 		{dir: "ossetenv/disable", options: map[string]string{"ossetenv": "false"}},
 
+		{dir: "osunsetenv/basic", options: map[string]string{"osunsetenv": "true"}},
+		{dir: "osunsetenv/dot", options: map[string]string{"osunsetenv": "true"}},
+		{dir: "osunsetenv/nottestfiles", options: map[string]string{"osunsetenv": "true"}},
+		{dir: "osunsetenv/disable", options: map[string]string{"osunsetenv": "false"}},
+
 		{dir: "ostempdir/basic", options: map[string]string{"ostempdir": "true"}},
 		{dir: "ostempdir/dot", options: map[string]string{"ostempdir": "true"}},
 		{dir: "ostempdir/nottestfiles", options: map[string]string{"ostempdir": "true"}},
+		{dir: "ostempdir/mkdirall", options: map[string]string{"ostempdir": "true"}},
 		{dir: "ostempdir/disable"},
 
 		{dir: "oscreatetemp/basic"},
 		{dir: "oscreatetemp/dot"},
 		{dir: "oscreatetemp/nottestfiles"},
+		{dir: "oscreatetemp/ioutil"},
+		{dir: "oscreatetemp/tempdir"},
 		{dir: "oscreatetemp/disable", options: map[string]string{"oscreatetemp": "false"}},
+
+		{dir: "tmppath/basic", options: map[string]string{"tmppath": "true"}},
+		{dir: "tmppath/dot", options: map[string]string{"tmppath": "true"}},
+		{dir: "tmppath/nottestfiles", options: map[string]string{"tmppath": "true"}},
+		{dir: "tmppath/disable"},
+
+		{dir: "testingparallel/basic"},
+		{dir: "testingparallel/dot"},
+		{dir: "testingparallel/nottestfiles"},
+		{dir: "testingparallel/disable", options: map[string]string{"testingparallel": "false"}},
+
+		{dir: "bloop/basic", options: map[string]string{"bloop": "true"}},
+		{dir: "bloop/dot", options: map[string]string{"bloop": "true"}},
+		{dir: "bloop/nottestfiles", options: map[string]string{"bloop": "true"}},
+		{dir: "bloop/disable"},
+
+		{dir: "testingoutput/basic", options: map[string]string{"testingoutput": "true"}},
+		{dir: "testingoutput/dot", options: map[string]string{"testingoutput": "true"}},
+		{dir: "testingoutput/nottestfiles", options: map[string]string{"testingoutput": "true"}},
+		{dir: "testingoutput/disable"},
+
+		{dir: "fmtprint/basic", options: map[string]string{"fmtprint": "true"}},
+		{dir: "fmtprint/dot", options: map[string]string{"fmtprint": "true"}},
+		{dir: "fmtprint/nottestfiles", options: map[string]string{"fmtprint": "true"}},
+		{dir: "fmtprint/disable"},
+
+		{dir: "testingfatal/basic", options: map[string]string{"testingfatal": "true"}},
+		{dir: "testingfatal/dot", options: map[string]string{"testingfatal": "true"}},
+		{dir: "testingfatal/nottestfiles", options: map[string]string{"testingfatal": "true"}},
+		{dir: "testingfatal/disable"},
+
+		{dir: "testmain/basic", options: map[string]string{"testmain": "true"}},
+		{dir: "testmain/dot", options: map[string]string{"testmain": "true"}},
+		{dir: "testmain/nottestfiles", options: map[string]string{"testmain": "true"}},
+		{dir: "testmain/defer", options: map[string]string{"testmain": "true"}},
+		{dir: "testmain/code", options: map[string]string{"testmain": "true"}},
+		{dir: "testmain/disable"},
+
+		{dir: "testingshorthand/basic", options: map[string]string{"testingshorthand": "true"}},
+		{dir: "testingshorthand/dot", options: map[string]string{"testingshorthand": "true"}},
+		{dir: "testingshorthand/nottestfiles", options: map[string]string{"testingshorthand": "true"}},
+		{dir: "testingshorthand/disable"},
+
+		{dir: "handles/alias"},
+		{dir: "handles/dot"},
+		{dir: "handles/field"},
+		{dir: "handles/fuzz"},
+		{dir: "handles/generic"},
+		{dir: "handles/position"},
+		{dir: "handles/escape", options: map[string]string{"contextbackground": "true"}},
+		{dir: "handles/subtest"},
 	}
 
 	for _, test := range testCases {